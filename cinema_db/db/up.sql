@@ -1,8 +1,77 @@
 CREATE EXTENSION postgis;
+CREATE EXTENSION pg_trgm;
+
+-- transliterates cyrillic text into latin in lower case, allows to match text in mixed scripts
+CREATE OR REPLACE FUNCTION transliterate(str TEXT)
+RETURNS TEXT
+AS $$
+    SELECT translate(
+        replace(replace(replace(replace(replace(replace(replace(replace(replace(lower(str),
+            'щ','shch'),'ж','zh'),'х','kh'),'ц','ts'),'ч','ch'),'ш','sh'),'ю','yu'),'я','ya'),'ё','e'),
+        'абвгдезийклмнопрстуфыэъь', 'abvgdeziiklmnoprstufye');
+$$
+LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+
+CREATE TABLE countries (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    -- ISO 3166-1 alpha-2 code, for example RU
+    code TEXT NOT NULL UNIQUE,
+    -- defaults for the country cities
+    -- ISO 4217 code, for example RUB
+    currency TEXT NOT NULL,
+    -- IANA time zone name
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    -- ISO 639-1 code in lower case, for example ru
+    locale TEXT NOT NULL
+);
+
+CREATE TABLE regions (
+    id SERIAL PRIMARY KEY,
+    country_id INT NOT NULL REFERENCES countries(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    UNIQUE(country_id, name)
+);
 
 CREATE TABLE cities (
     id SERIAL PRIMARY KEY,
-    name TEXT NOT NULL
+    name TEXT NOT NULL,
+    region_id INT REFERENCES regions(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    -- overrides of the country defaults, NULL if the country value is inherited
+    currency TEXT,
+    time_zone TEXT,
+    locale TEXT,
+    -- optional city boundary, unlike the cinemas coordinates points are stored longitude first,
+    -- as the geography type expects
+    boundary geography(MULTIPOLYGON,4326)
+);
+CREATE INDEX cities_boundary_idx ON cities USING GIST (boundary);
+CREATE INDEX ON cities(region_id);
+
+-- cities settings with the inherited country defaults
+CREATE VIEW cities_settings AS
+SELECT cities.id,
+COALESCE(cities.currency, countries.currency, '') AS currency,
+COALESCE(cities.time_zone, countries.time_zone, 'UTC') AS time_zone,
+COALESCE(cities.locale, countries.locale, '') AS locale
+FROM cities
+LEFT JOIN regions ON cities.region_id=regions.id
+LEFT JOIN countries ON regions.country_id=countries.id;
+CREATE INDEX cities_name_trgm_idx ON cities USING GIN (transliterate(name) gin_trgm_ops);
+-- allows to use index for the prefix search with LIKE
+CREATE INDEX cities_name_translit_idx ON cities (transliterate(name) text_pattern_ops);
+
+CREATE TABLE chains (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+-- brands under which the chain cinemas are operated
+CREATE TABLE brands (
+    id SERIAL PRIMARY KEY,
+    chain_id INT NOT NULL REFERENCES chains(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    UNIQUE(chain_id, name)
 );
 
 CREATE TABLE cinemas (
@@ -11,7 +80,62 @@ CREATE TABLE cinemas (
     city_id INT REFERENCES cities(id) ON UPDATE CASCADE ON DELETE SET NULL,
     --address without city
     address TEXT NOT NULL,
-    coordinates geography(POINT,4326) NOT NULL
+    coordinates geography(POINT,4326) NOT NULL,
+    -- default screenings sales policy, sales open before and close after the screening start
+    sales_open_before INTERVAL NOT NULL DEFAULT '14 days',
+    sales_close_after INTERVAL NOT NULL DEFAULT '15 minutes',
+    -- IANA time zone name, opening hours are in this time zone, if null the city time zone is used
+    time_zone TEXT,
+    phone TEXT NOT NULL DEFAULT '',
+    website TEXT NOT NULL DEFAULT '',
+    chain_id INT REFERENCES chains(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    brand_id INT REFERENCES brands(id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+CREATE INDEX ON cinemas(chain_id);
+CREATE INDEX cinemas_coordinates_idx ON cinemas USING GIST ((coordinates::geometry));
+-- coordinates with the longitude first for the geodesic distance ordering
+CREATE INDEX cinemas_geography_idx ON cinemas USING GIST ((ST_FlipCoordinates(coordinates::geometry)::geography));
+CREATE INDEX cinemas_search_trgm_idx ON cinemas USING GIN (transliterate(name || ' ' || address) gin_trgm_ops);
+
+-- amenities tags, for example parking, food_court, wheelchair_access
+CREATE TABLE amenities (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE cinemas_amenities (
+    cinema_id INT REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    amenity_id INT REFERENCES amenities(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY(cinema_id, amenity_id)
+);
+
+CREATE TABLE cinemas_photos (
+    id SERIAL PRIMARY KEY,
+    cinema_id INT NOT NULL REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    uploaded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX ON cinemas_photos(cinema_id);
+
+-- weekly opening hours, cinema without opening hours is considered always open
+CREATE TABLE cinemas_opening_hours (
+    cinema_id INT REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    -- 0 is Sunday
+    weekday SMALLINT CHECK(weekday BETWEEN 0 AND 6),
+    opens_at TIME NOT NULL,
+    -- if closes_at is not greater than opens_at, cinema closes after midnight
+    closes_at TIME NOT NULL,
+    PRIMARY KEY(cinema_id, weekday, opens_at)
+);
+
+-- exceptional closures, for example holidays or renovations
+CREATE TABLE cinemas_closures (
+    id SERIAL PRIMARY KEY,
+    cinema_id INT NOT NULL REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    CHECK(start_time < end_time)
 );
 
 CREATE TABLE halls_types (
@@ -24,35 +148,140 @@ CREATE TABLE halls (
     cinema_id INT REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE SET NULL,
     hall_type_id INT REFERENCES halls_types(type_id) ON UPDATE CASCADE ON DELETE SET NULL,
     name TEXT NOT NULL,
-    hall_size INT NOT NULL DEFAULT 0
+    -- hall_size and accessible_size are maintained by the halls_configurations triggers
+    hall_size INT NOT NULL DEFAULT 0,
+    accessible_size INT NOT NULL DEFAULT 0
+);
+
+-- hall capabilities catalogue, for example IMAX, Dolby Atmos, recliners
+CREATE TABLE capabilities (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE halls_capabilities (
+    hall_id INT REFERENCES halls(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    capability_id INT REFERENCES capabilities(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY(hall_id, capability_id)
 );
 
+-- all capabilities of the hall, the hall type is treated as a capability too,
+-- type_id is set only for the hall type to translate its name
+CREATE VIEW halls_capabilities_names AS
+    SELECT halls.id AS hall_id, halls_types.name, halls_types.type_id
+    FROM halls JOIN halls_types ON hall_type_id=halls_types.type_id
+    UNION
+    SELECT hall_id, capabilities.name, NULL
+    FROM halls_capabilities JOIN capabilities ON capability_id=capabilities.id;
+
+-- periods when the hall is offline, for example for projector servicing or cleaning
+CREATE TABLE halls_maintenance_windows (
+    id SERIAL PRIMARY KEY,
+    hall_id INT NOT NULL REFERENCES halls(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    CHECK(start_time < end_time)
+);
+CREATE INDEX ON halls_maintenance_windows(hall_id, end_time);
+
+-- seat categories, for example standard, vip, loveseat
+CREATE TABLE places_categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO places_categories (name) VALUES ('standard');
+
 CREATE TABLE halls_configurations (
     hall_id INT REFERENCES halls(id) ON UPDATE CASCADE ON DELETE CASCADE,
     row INT CHECK(row > 0),
     seat INT CHECK(seat > 0),
     grid_pos_x FLOAT NOT NULL,
     grid_pos_y FLOAT NOT NULL,
+    category_id INT NOT NULL DEFAULT 1 REFERENCES places_categories(id) ON UPDATE CASCADE,
+    -- place is suitable for wheelchair users
+    accessible BOOLEAN NOT NULL DEFAULT FALSE,
     PRIMARY KEY(hall_id, row, seat)
 );
 
-CREATE OR REPLACE FUNCTION update_hall_size()
+-- capacity of the hall per seat category, maintained by the halls_configurations triggers
+CREATE TABLE halls_capacities (
+    hall_id INT REFERENCES halls(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    category_id INT REFERENCES places_categories(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    capacity INT NOT NULL CHECK(capacity > 0),
+    PRIMARY KEY(hall_id, category_id)
+);
+
+-- recalculates capacity, accessible capacity and capacity per category for the specified halls
+CREATE OR REPLACE FUNCTION refresh_halls_capacities(halls_ids INT[])
+RETURNS VOID
+AS $$
+BEGIN
+    UPDATE halls SET hall_size=capacities.size, accessible_size=capacities.accessible_size
+    FROM (
+        SELECT halls.id, COUNT(halls_configurations.hall_id) AS size,
+        COUNT(halls_configurations.hall_id) FILTER (WHERE halls_configurations.accessible) AS accessible_size
+        FROM halls
+        LEFT JOIN halls_configurations ON halls_configurations.hall_id=halls.id
+        WHERE halls.id=ANY(halls_ids)
+        GROUP BY halls.id
+    ) AS capacities
+    WHERE halls.id=capacities.id;
+
+    DELETE FROM halls_capacities WHERE hall_id=ANY(halls_ids);
+    INSERT INTO halls_capacities (hall_id, category_id, capacity)
+    SELECT hall_id, category_id, COUNT(*)
+    FROM halls_configurations
+    WHERE hall_id=ANY(halls_ids)
+    GROUP BY hall_id, category_id;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_insert()
 RETURNS TRIGGER
 AS $$
 BEGIN
-    UPDATE halls SET hall_size=( SELECT COUNT(seat) FROM halls_configurations WHERE hall_id=id);
-    RETURN NEW;
+    PERFORM refresh_halls_capacities(ARRAY(SELECT DISTINCT hall_id FROM new_places));
+    RETURN NULL;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_update()
+RETURNS TRIGGER
+AS $$
+BEGIN
+    PERFORM refresh_halls_capacities(ARRAY(SELECT hall_id FROM new_places UNION SELECT hall_id FROM old_places));
+    RETURN NULL;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_delete()
+RETURNS TRIGGER
+AS $$
+BEGIN
+    PERFORM refresh_halls_capacities(ARRAY(SELECT DISTINCT hall_id FROM old_places));
+    RETURN NULL;
 END; $$
 LANGUAGE PLPGSQL;
 
 CREATE TRIGGER hall_place_insert_trigger
             AFTER INSERT ON halls_configurations
-            EXECUTE FUNCTION update_hall_size();
+            REFERENCING NEW TABLE AS new_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_insert();
+
+CREATE TRIGGER hall_place_update_trigger
+            AFTER UPDATE ON halls_configurations
+            REFERENCING OLD TABLE AS old_places NEW TABLE AS new_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_update();
 
 CREATE TRIGGER hall_place_delete_trigger
             AFTER DELETE ON halls_configurations
-            FOR EACH ROW
-            EXECUTE FUNCTION update_hall_size();
+            REFERENCING OLD TABLE AS old_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_delete();
 
 
 CREATE TABLE screenings_types (
@@ -64,16 +293,168 @@ CREATE TABLE screenings (
     id BIGSERIAL PRIMARY KEY,
     screening_type_id INT REFERENCES screenings_types(id) ON UPDATE CASCADE ON DELETE SET NULL,
     movie_id INT NOT NULL,
-    start_time TIMESTAMPTZ NOT NULL CHECK(start_time > clock_timestamp()),
+    -- the start time must be in the future on the screening creation, it's checked by the service,
+    -- because the table constraints are rechecked on every update
+    start_time TIMESTAMPTZ NOT NULL,
+    -- may be empty for the screenings scheduled without duration
+    end_time TIMESTAMPTZ CHECK(end_time > start_time),
     hall_id INT REFERENCES halls(id) ON UPDATE CASCADE ON DELETE SET NULL,
-    ticket_price DECIMAL(8,2) CHECK(ticket_price>0.0)
+    ticket_price DECIMAL(8,2) CHECK(ticket_price>0.0),
+    -- languages are ISO 639-1 codes in lower case, for example en, ru
+    spoken_language TEXT NOT NULL DEFAULT '',
+    -- empty if the screening is without subtitles
+    subtitles_language TEXT NOT NULL DEFAULT '',
+    -- the movie is screened in the original language
+    original_language BOOLEAN NOT NULL DEFAULT FALSE,
+    audio_description BOOLEAN NOT NULL DEFAULT FALSE,
+    closed_captions BOOLEAN NOT NULL DEFAULT FALSE,
+    -- for example 0+, 6+, 12+, 16+, 18+
+    age_rating TEXT NOT NULL DEFAULT '',
+    -- relaxed (sensory-friendly) screening, with lower sound and dimmed lights
+    relaxed BOOLEAN NOT NULL DEFAULT FALSE,
+    status TEXT NOT NULL DEFAULT 'scheduled' CHECK(status IN ('scheduled','cancelled','postponed','moved','sold_out')),
+    status_reason TEXT NOT NULL DEFAULT '',
+    status_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- screening that replaces the moved or postponed screening
+    replacement_screening_id BIGINT REFERENCES screenings(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    -- if not specified, filled from the cinema sales policy
+    sales_open_at TIMESTAMPTZ NOT NULL,
+    sales_close_at TIMESTAMPTZ NOT NULL,
+    CHECK(sales_open_at < sales_close_at)
+);
+
+-- fills not specified screening sales window from the sales policy of the screening cinema
+CREATE OR REPLACE FUNCTION fill_screening_sales_window()
+RETURNS TRIGGER
+AS $$
+DECLARE
+    open_before INTERVAL;
+    close_after INTERVAL;
+BEGIN
+    SELECT sales_open_before, sales_close_after INTO open_before, close_after
+    FROM cinemas JOIN halls ON halls.cinema_id=cinemas.id
+    WHERE halls.id=NEW.hall_id;
+
+    NEW.sales_open_at=COALESCE(NEW.sales_open_at, NEW.start_time - COALESCE(open_before, INTERVAL '14 days'));
+    NEW.sales_close_at=COALESCE(NEW.sales_close_at, NEW.start_time + COALESCE(close_after, INTERVAL '15 minutes'));
+    RETURN NEW;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE TRIGGER screening_sales_window_trigger
+            BEFORE INSERT ON screenings
+            FOR EACH ROW
+            EXECUTE FUNCTION fill_screening_sales_window();
+
+CREATE TABLE screenings_statuses_history (
+    id BIGSERIAL PRIMARY KEY,
+    screening_id BIGINT NOT NULL REFERENCES screenings(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    replacement_screening_id BIGINT REFERENCES screenings(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- film festivals, marathons, premieres
+CREATE TABLE events (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    CHECK(start_date <= end_date)
+);
+
+CREATE TABLE events_screenings (
+    event_id INT REFERENCES events(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    screening_id BIGINT REFERENCES screenings(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY(event_id, screening_id)
 );
+CREATE INDEX ON events_screenings(screening_id);
+
+-- names translations, the entities tables contain names in the default locale
+CREATE TABLE cities_translations (
+    id INT REFERENCES cities(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    -- ISO 639-1 code in lower case, for example en
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+CREATE INDEX cities_translations_name_translit_idx ON cities_translations (transliterate(name) text_pattern_ops);
+CREATE INDEX cities_translations_name_trgm_idx ON cities_translations USING GIN (transliterate(name) gin_trgm_ops);
+
+CREATE TABLE countries_translations (
+    id INT REFERENCES countries(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+
+CREATE TABLE regions_translations (
+    id INT REFERENCES regions(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+
+CREATE TABLE cinemas_translations (
+    id INT REFERENCES cinemas(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+CREATE INDEX cinemas_translations_name_trgm_idx ON cinemas_translations USING GIN (transliterate(name) gin_trgm_ops);
+
+CREATE TABLE halls_types_translations (
+    id INT REFERENCES halls_types(type_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+
+CREATE TABLE screenings_types_translations (
+    id INT REFERENCES screenings_types(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    locale TEXT NOT NULL,
+    name TEXT NOT NULL,
+    PRIMARY KEY(id, locale)
+);
+
+GRANT SELECT ON countries TO cinema_service;
+GRANT SELECT ON regions TO cinema_service;
 GRANT SELECT ON cities TO cinema_service;
+GRANT SELECT ON cities_settings TO cinema_service;
 GRANT SELECT ON cinemas TO cinema_service;
+GRANT SELECT ON chains TO cinema_service;
+GRANT SELECT ON brands TO cinema_service;
 GRANT SELECT ON halls_configurations TO cinema_service;
 GRANT SELECT ON halls_types TO cinema_service;
+GRANT SELECT ON capabilities TO cinema_service;
+GRANT SELECT ON halls_capabilities TO cinema_service;
+GRANT SELECT ON halls_capabilities_names TO cinema_service;
+GRANT SELECT ON places_categories TO cinema_service;
+GRANT SELECT ON halls_capacities TO cinema_service;
+
+GRANT SELECT ON cinemas_opening_hours TO cinema_service;
+GRANT SELECT ON cinemas_closures TO cinema_service;
+GRANT SELECT ON amenities TO cinema_service;
+GRANT SELECT ON cinemas_amenities TO cinema_service;
+GRANT SELECT, INSERT ON cinemas_photos TO cinema_service;
+GRANT USAGE ON SEQUENCE cinemas_photos_id_seq TO cinema_service;
 
 GRANT SELECT ON halls TO cinema_service;
-GRANT SELECT ON screenings TO cinema_service;
+GRANT SELECT, INSERT ON halls_maintenance_windows TO cinema_service;
+GRANT USAGE ON SEQUENCE halls_maintenance_windows_id_seq TO cinema_service;
+GRANT SELECT, INSERT ON screenings TO cinema_service;
+GRANT USAGE ON SEQUENCE screenings_id_seq TO cinema_service;
+GRANT UPDATE (status, status_reason, status_changed_at, replacement_screening_id) ON screenings TO cinema_service;
+GRANT SELECT, INSERT ON screenings_statuses_history TO cinema_service;
+GRANT USAGE ON SEQUENCE screenings_statuses_history_id_seq TO cinema_service;
 GRANT SELECT ON screenings_types TO cinema_service;
+GRANT SELECT ON events TO cinema_service;
+GRANT SELECT ON countries_translations TO cinema_service;
+GRANT SELECT ON regions_translations TO cinema_service;
+GRANT SELECT ON cities_translations TO cinema_service;
+GRANT SELECT ON cinemas_translations TO cinema_service;
+GRANT SELECT ON halls_types_translations TO cinema_service;
+GRANT SELECT ON screenings_types_translations TO cinema_service;
+GRANT SELECT ON events_screenings TO cinema_service;
 
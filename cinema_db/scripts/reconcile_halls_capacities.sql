@@ -0,0 +1,106 @@
+-- Recalculates capacities of all halls from their configurations.
+-- The script is idempotent, it also upgrades databases created before the capacities were maintained per hall and per seat category.
+-- Usage: psql -U ${POSTGRES_USER} -d cinema -f reconcile_halls_capacities.sql
+BEGIN;
+
+ALTER TABLE halls ADD COLUMN IF NOT EXISTS accessible_size INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS places_categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+INSERT INTO places_categories (name) VALUES ('standard') ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE halls_configurations
+    ADD COLUMN IF NOT EXISTS category_id INT NOT NULL DEFAULT 1 REFERENCES places_categories(id) ON UPDATE CASCADE,
+    ADD COLUMN IF NOT EXISTS accessible BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS halls_capacities (
+    hall_id INT REFERENCES halls(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    category_id INT REFERENCES places_categories(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    capacity INT NOT NULL CHECK(capacity > 0),
+    PRIMARY KEY(hall_id, category_id)
+);
+
+DROP FUNCTION IF EXISTS update_hall_size() CASCADE;
+
+-- recalculates capacity, accessible capacity and capacity per category for the specified halls
+CREATE OR REPLACE FUNCTION refresh_halls_capacities(halls_ids INT[])
+RETURNS VOID
+AS $$
+BEGIN
+    UPDATE halls SET hall_size=capacities.size, accessible_size=capacities.accessible_size
+    FROM (
+        SELECT halls.id, COUNT(halls_configurations.hall_id) AS size,
+        COUNT(halls_configurations.hall_id) FILTER (WHERE halls_configurations.accessible) AS accessible_size
+        FROM halls
+        LEFT JOIN halls_configurations ON halls_configurations.hall_id=halls.id
+        WHERE halls.id=ANY(halls_ids)
+        GROUP BY halls.id
+    ) AS capacities
+    WHERE halls.id=capacities.id;
+
+    DELETE FROM halls_capacities WHERE hall_id=ANY(halls_ids);
+    INSERT INTO halls_capacities (hall_id, category_id, capacity)
+    SELECT hall_id, category_id, COUNT(*)
+    FROM halls_configurations
+    WHERE hall_id=ANY(halls_ids)
+    GROUP BY hall_id, category_id;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_insert()
+RETURNS TRIGGER
+AS $$
+BEGIN
+    PERFORM refresh_halls_capacities(ARRAY(SELECT DISTINCT hall_id FROM new_places));
+    RETURN NULL;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_update()
+RETURNS TRIGGER
+AS $$
+BEGIN
+    PERFORM refresh_halls_capacities(ARRAY(SELECT hall_id FROM new_places UNION SELECT hall_id FROM old_places));
+    RETURN NULL;
+END; $$
+LANGUAGE PLPGSQL;
+
+CREATE OR REPLACE FUNCTION update_halls_capacities_on_delete()
+RETURNS TRIGGER
+AS $$
+BEGIN
+    PERFORM refresh_halls_capacities(ARRAY(SELECT DISTINCT hall_id FROM old_places));
+    RETURN NULL;
+END; $$
+LANGUAGE PLPGSQL;
+
+DROP TRIGGER IF EXISTS hall_place_insert_trigger ON halls_configurations;
+CREATE TRIGGER hall_place_insert_trigger
+            AFTER INSERT ON halls_configurations
+            REFERENCING NEW TABLE AS new_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_insert();
+
+DROP TRIGGER IF EXISTS hall_place_update_trigger ON halls_configurations;
+CREATE TRIGGER hall_place_update_trigger
+            AFTER UPDATE ON halls_configurations
+            REFERENCING OLD TABLE AS old_places NEW TABLE AS new_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_update();
+
+DROP TRIGGER IF EXISTS hall_place_delete_trigger ON halls_configurations;
+CREATE TRIGGER hall_place_delete_trigger
+            AFTER DELETE ON halls_configurations
+            REFERENCING OLD TABLE AS old_places
+            FOR EACH STATEMENT
+            EXECUTE FUNCTION update_halls_capacities_on_delete();
+
+SELECT refresh_halls_capacities(ARRAY(SELECT id FROM halls));
+
+GRANT SELECT ON places_categories TO cinema_service;
+GRANT SELECT ON halls_capacities TO cinema_service;
+
+COMMIT;
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 
 	"github.com/Falokut/cinema_service/internal/config"
 	"github.com/Falokut/cinema_service/internal/handler"
+	"github.com/Falokut/cinema_service/internal/mediastorage"
+	"github.com/Falokut/cinema_service/internal/moviecatalog"
 	"github.com/Falokut/cinema_service/internal/repository"
 	"github.com/Falokut/cinema_service/internal/repository/postgresrepository"
 	"github.com/Falokut/cinema_service/internal/repository/rediscache"
+	"github.com/Falokut/cinema_service/internal/seatavailability"
 	"github.com/Falokut/cinema_service/internal/service"
 	cinema_service "github.com/Falokut/cinema_service/pkg/cinema_service/v1/protos"
 	jaegerTracer "github.com/Falokut/cinema_service/pkg/jaeger"
@@ -155,7 +159,8 @@ func main() {
 	defer hallsConfigurationsRdb.Close()
 
 	cinemaCache := rediscache.NewCinemaCache(logger.Logger, citiesCinemas, cinemasRdb, citiesRdb,
-		hallsConfigurationsRdb, hallsRdb, metric)
+		hallsConfigurationsRdb, hallsRdb, metric,
+		append([]string{cfg.Localization.DefaultLocale}, cfg.Localization.SupportedLocales...))
 
 	initHealthcheck(cfg, shutdown, []healthcheck.HealthcheckResource{cinemaDB, cinemaCache})
 
@@ -169,12 +174,62 @@ func main() {
 			CitiesCinemasTTL:     cfg.CitiesCinemasCache.TTL,
 		})
 
-	s := service.NewCinemaService(repositoryWithCache)
-	h := handler.NewCinemaServiceHandler(s)
+	var seatsProvider service.SeatAvailabilityProvider
+	if cfg.SeatAvailabilityProvider.Addr != "" {
+		grpcSeatsProvider, err := seatavailability.NewGrpcProvider(cfg.SeatAvailabilityProvider)
+		if err != nil {
+			logger.Errorf("Shutting down, can't create seats availability provider %v", err)
+			return
+		}
+		defer grpcSeatsProvider.Close()
+		seatsProvider = grpcSeatsProvider
+	} else {
+		logger.Warn("Seats availability provider address not specified, seats availability will be unknown")
+	}
+
+	mediaStorage, err := mediastorage.NewLocalStorage(cfg.MediaStorage)
+	if err != nil {
+		logger.Errorf("Shutting down, can't create media storage %v", err)
+		return
+	}
+
+	var movieCatalog service.MovieCatalog
+	switch {
+	case cfg.MovieCatalog.Addr != "":
+		grpcCatalog, err := moviecatalog.NewGrpcCatalog(cfg.MovieCatalog)
+		if err != nil {
+			logger.Errorf("Shutting down, can't create movie catalog %v", err)
+			return
+		}
+		defer grpcCatalog.Close()
+		movieCatalog = grpcCatalog
+		if cfg.MovieCatalog.CacheTTL > 0 {
+			movieCatalog = moviecatalog.NewCachingCatalog(grpcCatalog, cfg.MovieCatalog.CacheTTL)
+		}
+	case cfg.MovieCatalog.StaticFile != "":
+		movieCatalog, err = moviecatalog.NewStaticCatalog(cfg.MovieCatalog.StaticFile)
+		if err != nil {
+			logger.Errorf("Shutting down, can't load static movie catalog %v", err)
+			return
+		}
+	default:
+		logger.Warn("Movie catalog address not specified, screenings won't be enriched and duration is required")
+	}
+
+	s := service.NewCinemaService(logger.Logger, repositoryWithCache, seatsProvider, mediaStorage, movieCatalog)
+	locales := handler.NewLocaleResolver(cfg.Localization.DefaultLocale, cfg.Localization.SupportedLocales)
+	h := handler.NewCinemaServiceHandler(s, locales)
+
+	restMux := newRestMux()
+	if err := handler.NewCinemasExportHandler(logger.Logger, s, locales).Register(restMux); err != nil {
+		logger.Errorf("Shutting down, can't register cinemas export handler %v", err)
+		return
+	}
+
 	logger.Info("Server initializing")
 	serv := server.NewServer(logger.Logger, h)
 	go func() {
-		if err := serv.Run(getListenServerConfig(cfg), metric, nil, nil); err != nil {
+		if err := serv.Run(getListenServerConfig(cfg), metric, nil, restMux); err != nil {
 			logger.Errorf("Shutting down, error while running server %s", err.Error())
 			shutdown <- err
 			return
@@ -194,12 +249,27 @@ func main() {
 	serv.Shutdown()
 }
 
+// locale of the names translations is selected by the Accept-Language header
+var allowedHeaders = []string{"Accept-Language"}
+
+// newRestMux returns the mux for the grpc gateway and the plain http handlers,
+// the custom mux replaces the server default mux, so the allowed headers are matched here too.
+func newRestMux() *runtime.ServeMux {
+	return runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(func(header string) (string, bool) {
+		if slices.Contains(allowedHeaders, header) {
+			return header, true
+		}
+		return runtime.DefaultHeaderMatcher(header)
+	}))
+}
+
 func getListenServerConfig(cfg *config.Config) server.Config {
 	return server.Config{
-		Mode:        cfg.Listen.Mode,
-		Host:        cfg.Listen.Host,
-		Port:        cfg.Listen.Port,
-		ServiceDesc: &cinema_service.CinemaServiceV1_ServiceDesc,
+		Mode:           cfg.Listen.Mode,
+		Host:           cfg.Listen.Host,
+		Port:           cfg.Listen.Port,
+		ServiceDesc:    &cinema_service.CinemaServiceV1_ServiceDesc,
+		AllowedHeaders: allowedHeaders,
 		RegisterRestHandlerServer: func(ctx context.Context, mux *runtime.ServeMux, service any) error {
 			serv, ok := service.(cinema_service.CinemaServiceV1Server)
 			if !ok {
@@ -4,7 +4,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Falokut/cinema_service/internal/mediastorage"
+	"github.com/Falokut/cinema_service/internal/moviecatalog"
 	"github.com/Falokut/cinema_service/internal/repository"
+	"github.com/Falokut/cinema_service/internal/seatavailability"
 	"github.com/Falokut/cinema_service/pkg/jaeger"
 	"github.com/Falokut/cinema_service/pkg/logging"
 	"github.com/Falokut/cinema_service/pkg/metrics"
@@ -66,6 +69,16 @@ type Config struct {
 		Password string        `yaml:"password" env:"HALLS_CONFIGURATIONS_CACHE_PASSWORD"`
 		TTL      time.Duration `yaml:"ttl"`
 	} `yaml:"halls_configurations_cache"`
+
+	SeatAvailabilityProvider seatavailability.Config `yaml:"seat_availability_provider"`
+	MediaStorage             mediastorage.Config     `yaml:"media_storage"`
+	MovieCatalog             moviecatalog.Config     `yaml:"movie_catalog"`
+
+	Localization struct {
+		// Locale of the names stored without translations.
+		DefaultLocale    string   `yaml:"default_locale" env:"DEFAULT_LOCALE"`
+		SupportedLocales []string `yaml:"supported_locales" env:"SUPPORTED_LOCALES" env-separator:","`
+	} `yaml:"localization"`
 }
 
 var instance *Config
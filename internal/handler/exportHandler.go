@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	"github.com/Falokut/cinema_service/internal/service"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+const cinemasExportPath = "/v1/cinemas/export"
+
+// CinemasExportHandler exports the cinemas locations as GeoJSON or KML files over REST.
+type CinemasExportHandler struct {
+	logger  *logrus.Logger
+	s       service.CinemaService
+	locales *LocaleResolver
+}
+
+func NewCinemasExportHandler(logger *logrus.Logger, s service.CinemaService,
+	locales *LocaleResolver) *CinemasExportHandler {
+	return &CinemasExportHandler{logger: logger, s: s, locales: locales}
+}
+
+// Register registers GET /v1/cinemas/export?format={geojson|kml}&city_id={id}&chain_id={id}
+func (h *CinemasExportHandler) Register(mux *runtime.ServeMux) error {
+	return mux.HandlePath(http.MethodGet, cinemasExportPath, h.ExportCinemas)
+}
+
+func (h *CinemasExportHandler) ExportCinemas(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+	filter, err := cinemasExportFilterFromQuery(query.Get("city_id"), query.Get("chain_id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var encoder cinemasEncoder
+	format := models.GeoFormat(query.Get("format"))
+	switch format {
+	case models.GeoFormatGeoJSON, "":
+		format = models.GeoFormatGeoJSON
+		encoder = &geoJSONEncoder{}
+	case models.GeoFormatKML:
+		encoder = &kmlEncoder{}
+	default:
+		http.Error(w, "invalid format value, it must be geojson or kml", http.StatusBadRequest)
+		return
+	}
+
+	// the header and the first bytes are written only with the first cinema,
+	// so the error status can be returned if the query fails
+	started := false
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", encoder.contentType())
+		w.Header().Set("Content-Disposition", "attachment; filename=\"cinemas."+string(format)+"\"")
+		w.WriteHeader(http.StatusOK)
+		return encoder.begin(w)
+	}
+
+	ctx := h.locales.withRequestLocale(r.Context(), r)
+	err = h.s.ExportCinemas(ctx, filter, format, func(cinema models.CinemaFeature) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return encoder.encode(w, cinema)
+	})
+
+	if err == nil && !started {
+		err = start()
+	}
+	if err == nil {
+		err = encoder.end(w)
+	}
+	if err != nil {
+		h.handleError(ctx, w, err, started)
+	}
+}
+
+// handleError writes the error response, if the response is started, the error is only logged.
+func (h *CinemasExportHandler) handleError(ctx context.Context, w http.ResponseWriter, err error, started bool) {
+	if started {
+		h.logger.WithContext(ctx).Errorf("cinemas export interrupted: %v", err)
+		return
+	}
+
+	serviceErr := &models.ServiceError{}
+	if !errors.As(err, &serviceErr) {
+		h.logger.WithContext(ctx).Errorf("cinemas export failed: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	http.Error(w, serviceErr.Msg, runtime.HTTPStatusFromCode(convertServiceErrCodeToGrpc(serviceErr.Code)))
+}
+
+func cinemasExportFilterFromQuery(cityID, chainID string) (filter models.CinemasExportFilter, err error) {
+	if filter.CityID, err = parseOptionalID(cityID); err != nil {
+		return filter, errors.New("invalid city_id value, it must be a number")
+	}
+	if filter.ChainID, err = parseOptionalID(chainID); err != nil {
+		return filter, errors.New("invalid chain_id value, it must be a number")
+	}
+	return
+}
+
+func parseOptionalID(str string) (*int32, error) {
+	if str == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(str, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	id32 := int32(id)
+	return &id32, nil
+}
+
+type cinemasEncoder interface {
+	contentType() string
+	begin(w io.Writer) error
+	encode(w io.Writer, cinema models.CinemaFeature) error
+	end(w io.Writer) error
+}
+
+type geoJSONEncoder struct {
+	notFirst bool
+}
+
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	Geometry   json.RawMessage   `json:"geometry"`
+	Properties geoJSONProperties `json:"properties"`
+}
+
+type geoJSONProperties struct {
+	ID      int32  `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	CityID  int32  `json:"city_id"`
+	Phone   string `json:"phone,omitempty"`
+	Website string `json:"website,omitempty"`
+	ChainID int32  `json:"chain_id,omitempty"`
+	Chain   string `json:"chain,omitempty"`
+	Brand   string `json:"brand,omitempty"`
+}
+
+func (e *geoJSONEncoder) contentType() string {
+	return "application/geo+json"
+}
+
+func (e *geoJSONEncoder) begin(w io.Writer) error {
+	_, err := io.WriteString(w, `{"type":"FeatureCollection","features":[`)
+	return err
+}
+
+func (e *geoJSONEncoder) encode(w io.Writer, cinema models.CinemaFeature) error {
+	feature, err := json.Marshal(geoJSONFeature{
+		Type:     "Feature",
+		Geometry: json.RawMessage(cinema.Geometry),
+		Properties: geoJSONProperties{
+			ID:      cinema.ID,
+			Name:    cinema.Name,
+			Address: cinema.Address,
+			CityID:  cinema.CityID,
+			Phone:   cinema.Phone,
+			Website: cinema.Website,
+			ChainID: cinema.ChainID,
+			Chain:   cinema.Chain,
+			Brand:   cinema.Brand,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if e.notFirst {
+		if _, err = io.WriteString(w, ","); err != nil {
+			return err
+		}
+	}
+	e.notFirst = true
+	_, err = w.Write(feature)
+	return err
+}
+
+func (e *geoJSONEncoder) end(w io.Writer) error {
+	_, err := io.WriteString(w, "]}")
+	return err
+}
+
+type kmlEncoder struct{}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlPlacemark struct {
+	XMLName      xml.Name  `xml:"Placemark"`
+	ID           string    `xml:"id,attr"`
+	Name         string    `xml:"name"`
+	Address      string    `xml:"address"`
+	Phone        string    `xml:"phoneNumber,omitempty"`
+	ExtendedData []kmlData `xml:"ExtendedData>Data"`
+	// point in KML, selected by the database
+	Geometry string `xml:",innerxml"`
+}
+
+func (e *kmlEncoder) contentType() string {
+	return "application/vnd.google-earth.kml+xml"
+}
+
+func (e *kmlEncoder) begin(w io.Writer) error {
+	_, err := io.WriteString(w, xml.Header+`<kml xmlns="http://www.opengis.net/kml/2.2"><Document>`)
+	return err
+}
+
+func (e *kmlEncoder) encode(w io.Writer, cinema models.CinemaFeature) error {
+	placemark := kmlPlacemark{
+		ID:      "cinema-" + strconv.Itoa(int(cinema.ID)),
+		Name:    cinema.Name,
+		Address: cinema.Address,
+		Phone:   cinema.Phone,
+		ExtendedData: []kmlData{
+			{Name: "id", Value: strconv.Itoa(int(cinema.ID))},
+			{Name: "city_id", Value: strconv.Itoa(int(cinema.CityID))},
+		},
+		Geometry: cinema.Geometry,
+	}
+	if cinema.Website != "" {
+		placemark.ExtendedData = append(placemark.ExtendedData, kmlData{Name: "website", Value: cinema.Website})
+	}
+	if cinema.ChainID != 0 {
+		placemark.ExtendedData = append(placemark.ExtendedData,
+			kmlData{Name: "chain_id", Value: strconv.Itoa(int(cinema.ChainID))},
+			kmlData{Name: "chain", Value: cinema.Chain})
+	}
+	if cinema.Brand != "" {
+		placemark.ExtendedData = append(placemark.ExtendedData, kmlData{Name: "brand", Value: cinema.Brand})
+	}
+
+	return xml.NewEncoder(w).Encode(placemark)
+}
+
+func (e *kmlEncoder) end(w io.Writer) error {
+	_, err := io.WriteString(w, "</Document></kml>")
+	return err
+}
@@ -40,18 +40,34 @@ type CinemaRepository interface {
 
 type CinemaServiceHandler struct {
 	cinema_service.UnimplementedCinemaServiceV1Server
-	s service.CinemaService
+	s       service.CinemaService
+	locales *LocaleResolver
 }
 
-func NewCinemaServiceHandler(s service.CinemaService) *CinemaServiceHandler {
-	return &CinemaServiceHandler{s: s}
+func NewCinemaServiceHandler(s service.CinemaService, locales *LocaleResolver) *CinemaServiceHandler {
+	return &CinemaServiceHandler{s: s, locales: locales}
 }
 
 func (h *CinemaServiceHandler) GetCinemasInCity(ctx context.Context,
 	in *cinema_service.GetCinemasInCityRequest) (cinemas *cinema_service.Cinemas, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
-	modelsCinema, err := h.s.GetCinemasInCity(ctx, in.CityID)
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	filter := models.CinemasFilter{Amenities: parseNames(in.GetAmenities()), ChainsIDs: chainsIDs}
+	if in.OpenAt != nil {
+		openAt, perr := time.Parse(time.RFC3339, in.OpenAt.FormattedTimestamp)
+		if perr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid open_at value, it must be RFC3339 layout value")
+		}
+		filter.OpenAt = &openAt
+	}
+
+	modelsCinema, err := h.s.GetCinemasInCity(ctx, in.CityID, filter)
 	if err != nil {
 		return
 	}
@@ -70,11 +86,18 @@ func (h *CinemaServiceHandler) GetCinemasInCity(ctx context.Context,
 func (h *CinemaServiceHandler) GetMoviesScreenings(ctx context.Context,
 	in *cinema_service.GetMoviesScreeningsRequest) (screenings *cinema_service.PreviewScreenings, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 	start, end, err := parsePeriods(in.StartPeriod, in.EndPeriod)
 	if err != nil {
 		return
 	}
-	modelsScreenings, err := h.s.GetMoviesScreenings(ctx, in.CinemaID, start, end)
+	filter, err := screeningsFilterFromRequest(in)
+	if err != nil {
+		return
+	}
+
+	modelsScreenings, err := h.s.GetMoviesScreenings(ctx, in.CinemaID, start, end,
+		filter)
 	if err != nil {
 		return
 	}
@@ -85,6 +108,7 @@ func (h *CinemaServiceHandler) GetMoviesScreenings(ctx context.Context,
 func (h *CinemaServiceHandler) GetMoviesScreeningsInCities(ctx context.Context,
 	in *cinema_service.GetMoviesScreeningsInCitiesRequest) (screenings *cinema_service.PreviewScreenings, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	start, end, err := parsePeriods(in.StartPeriod, in.EndPeriod)
 	if err != nil {
@@ -101,7 +125,18 @@ func (h *CinemaServiceHandler) GetMoviesScreeningsInCities(ctx context.Context,
 		ids = convertStringsSlice(strings.Split(citiesIDs, ","))
 	}
 
-	modelsScreenings, err := h.s.GetMoviesScreeningsInCities(ctx, ids, start, end)
+	regionsIDs, err := parseIds(in.GetRegionsIds())
+	if err != nil {
+		return
+	}
+
+	filter, err := screeningsFilterFromRequest(in)
+	if err != nil {
+		return
+	}
+
+	modelsScreenings, err := h.s.GetMoviesScreeningsInCities(ctx, ids, regionsIDs, start, end,
+		filter, in.IncludeStats)
 	if err != nil {
 		return
 	}
@@ -119,12 +154,29 @@ func previewScreeningsFromModel(screenings []models.MoviesScreenings) *cinema_se
 			MovieID:         screenings[i].MovieID,
 			ScreeningsTypes: screenings[i].ScreeningsTypes,
 			HallsTypes:      screenings[i].HallsTypes,
+			Stats:           movieScreeningsStatsFromModel(screenings[i].Stats),
 		}
 	}
 
 	return converted
 }
 
+func movieScreeningsStatsFromModel(stats *models.MovieScreeningsStats) *cinema_service.MovieScreeningsStats {
+	if stats == nil {
+		return nil
+	}
+
+	return &cinema_service.MovieScreeningsStats{
+		ScreeningsCount:   stats.ScreeningsCount,
+		CinemasCount:      stats.CinemasCount,
+		EarliestStartTime: formattedTimestampFromTime(stats.EarliestStartTime),
+		LatestStartTime:   formattedTimestampFromTime(stats.LatestStartTime),
+		MinPrice:          priceFromString(stats.MinPrice),
+		MaxPrice:          priceFromString(stats.MaxPrice),
+		CitiesIds:         stats.CitiesIDs,
+	}
+}
+
 func convertStringsSlice(str []string) []int32 {
 	var nums = make([]int32, 0, len(str))
 	for _, s := range str {
@@ -136,17 +188,119 @@ func convertStringsSlice(str []string) []int32 {
 	return nums
 }
 
-func hallConfigurationFromModel(places []models.Place) *cinema_service.HallConfiguration {
+// screeningsFilterRequest is implemented by all screenings listing requests.
+type screeningsFilterRequest interface {
+	GetHallsCapabilities() string
+	GetSpokenLanguage() string
+	GetSubtitlesLanguage() string
+	GetOriginalLanguage() bool
+	GetAudioDescription() bool
+	GetClosedCaptions() bool
+	GetRelaxed() bool
+	GetAgeRatings() string
+	GetIncludeCancelled() bool
+	GetPurchasableOnly() bool
+	GetChainsIds() string
+}
+
+func screeningsFilterFromRequest(in screeningsFilterRequest) (models.ScreeningsFilter, error) {
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return models.ScreeningsFilter{}, err
+	}
+
+	return models.ScreeningsFilter{
+		HallsCapabilities: parseNames(in.GetHallsCapabilities()),
+		SpokenLanguage:    strings.TrimSpace(in.GetSpokenLanguage()),
+		SubtitlesLanguage: strings.TrimSpace(in.GetSubtitlesLanguage()),
+		OriginalLanguage:  in.GetOriginalLanguage(),
+		AudioDescription:  in.GetAudioDescription(),
+		ClosedCaptions:    in.GetClosedCaptions(),
+		Relaxed:           in.GetRelaxed(),
+		AgeRatings:        parseNames(in.GetAgeRatings()),
+		IncludeCancelled:  in.GetIncludeCancelled(),
+		PurchasableOnly:   in.GetPurchasableOnly(),
+		ChainsIDs:         chainsIDs,
+	}, nil
+}
+
+// parseIds parses the comma separated ids, returns nil if the string is empty.
+func parseIds(str string) ([]int32, error) {
+	str = strings.ReplaceAll(str, `"`, "")
+	if str == "" {
+		return nil, nil
+	}
+	if err := checkIds(str); err != nil {
+		return nil, err
+	}
+	return convertStringsSlice(strings.Split(str, ",")), nil
+}
+
+func screeningAttributesFromModel(attributes *models.ScreeningAttributes) *cinema_service.ScreeningAttributes {
+	return &cinema_service.ScreeningAttributes{
+		SpokenLanguage:    attributes.SpokenLanguage,
+		SubtitlesLanguage: attributes.SubtitlesLanguage,
+		OriginalLanguage:  attributes.OriginalLanguage,
+		AudioDescription:  attributes.AudioDescription,
+		ClosedCaptions:    attributes.ClosedCaptions,
+		AgeRating:         attributes.AgeRating,
+		Relaxed:           attributes.Relaxed,
+	}
+}
+
+var screeningStatusesToProto = map[models.ScreeningStatus]cinema_service.ScreeningStatus{
+	models.ScreeningStatusScheduled: cinema_service.ScreeningStatus_SCREENING_STATUS_SCHEDULED,
+	models.ScreeningStatusCancelled: cinema_service.ScreeningStatus_SCREENING_STATUS_CANCELLED,
+	models.ScreeningStatusPostponed: cinema_service.ScreeningStatus_SCREENING_STATUS_POSTPONED,
+	models.ScreeningStatusMoved:     cinema_service.ScreeningStatus_SCREENING_STATUS_MOVED,
+	models.ScreeningStatusSoldOut:   cinema_service.ScreeningStatus_SCREENING_STATUS_SOLD_OUT,
+}
+
+func screeningStatusFromProto(status cinema_service.ScreeningStatus) (models.ScreeningStatus, bool) {
+	for modelStatus, protoStatus := range screeningStatusesToProto {
+		if protoStatus == status {
+			return modelStatus, true
+		}
+	}
+	return "", false
+}
+
+func screeningStatusFromModel(info *models.ScreeningStatusInfo) *cinema_service.ScreeningStatusInfo {
+	return &cinema_service.ScreeningStatusInfo{
+		Status:                 screeningStatusesToProto[info.Status],
+		Reason:                 info.Reason,
+		ChangedAt:              formattedTimestampFromTime(info.ChangedAt),
+		ReplacementScreeningID: info.ReplacementScreeningID,
+	}
+}
+
+// parseNames splits the comma separated names, empty names are skipped.
+func parseNames(str string) []string {
+	var names []string
+	for _, name := range strings.Split(str, ",") {
+		name = strings.TrimSpace(strings.ReplaceAll(name, `"`, ""))
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func hallConfigurationFromModel(configuration *models.HallConfiguration) *cinema_service.HallConfiguration {
+	places := configuration.Places
 	converted := &cinema_service.HallConfiguration{
-		Place: make([]*cinema_service.Place, len(places)),
+		Place:       make([]*cinema_service.Place, len(places)),
+		TotalPlaces: configuration.TotalPlaces,
+		FreePlaces:  configuration.FreePlaces,
 	}
 
 	for i := range places {
 		converted.Place[i] = &cinema_service.Place{
-			Row:      places[i].Row,
-			Seat:     places[i].Seat,
-			GridPosX: places[i].GridPosX,
-			GridPosY: places[i].GridPosY,
+			Row:          places[i].Row,
+			Seat:         places[i].Seat,
+			GridPosX:     places[i].GridPosX,
+			GridPosY:     places[i].GridPosY,
+			Availability: cinema_service.PlaceAvailability(places[i].Availability),
 		}
 	}
 
@@ -156,20 +310,33 @@ func hallConfigurationFromModel(places []models.Place) *cinema_service.HallConfi
 func (h *CinemaServiceHandler) GetScreenings(ctx context.Context,
 	in *cinema_service.GetScreeningsRequest) (screenings *cinema_service.Screenings, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	start, end, err := parsePeriods(in.StartPeriod, in.EndPeriod)
 	if err != nil {
 		return
 	}
 
-	modelsScreenings, err := h.s.GetScreenings(ctx, in.CinemaID, in.MovieID, start, end)
+	filter, err := screeningsFilterFromRequest(in)
+	if err != nil {
+		return
+	}
+
+	modelsScreenings, err := h.s.GetScreenings(ctx, in.CinemaID, in.MovieID, start, end,
+		filter, in.Enrich)
 	if err != nil {
 		return
 	}
 
-	screenings = &cinema_service.Screenings{
+	screenings = screeningsFromModel(modelsScreenings)
+	return
+}
+
+func screeningsFromModel(modelsScreenings []models.Screening) *cinema_service.Screenings {
+	screenings := &cinema_service.Screenings{
 		Screenings: make([]*cinema_service.Screening, len(modelsScreenings)),
 	}
+	now := time.Now()
 
 	for i := range modelsScreenings {
 		screenings.Screenings[i] = &cinema_service.Screening{
@@ -179,22 +346,80 @@ func (h *CinemaServiceHandler) GetScreenings(ctx context.Context,
 			StartTime:     &cinema_service.Timestamp{FormattedTimestamp: modelsScreenings[i].StartTime.Format(time.RFC3339)},
 			HallID:        modelsScreenings[i].HallID,
 			TicketPrice:   priceFromString(modelsScreenings[i].TicketPrice),
+			Attributes:    screeningAttributesFromModel(&modelsScreenings[i].ScreeningAttributes),
+			Status:        screeningStatusFromModel(&modelsScreenings[i].ScreeningStatusInfo),
+			SalesOpenAt:   formattedTimestampFromTime(modelsScreenings[i].SalesOpenAt),
+			SalesCloseAt:  formattedTimestampFromTime(modelsScreenings[i].SalesCloseAt),
+			Purchasable:   modelsScreenings[i].IsPurchasable(modelsScreenings[i].Status, now),
+			CinemaID:      modelsScreenings[i].CinemaID,
+			EventsIDs:     modelsScreenings[i].EventsIDs,
+			EndTime:       optionalTimestampFromTime(modelsScreenings[i].EndTime),
+			MovieTitle:    modelsScreenings[i].MovieTitle,
 		}
 	}
 
+	return screenings
+}
+
+func (h *CinemaServiceHandler) ListEvents(ctx context.Context,
+	in *cinema_service.ListEventsRequest) (events *cinema_service.Events, err error) {
+	defer h.handleError(&err)
+
+	modelsEvents, err := h.s.ListEvents(ctx, in.CityID)
+	if err != nil {
+		return
+	}
+
+	events = &cinema_service.Events{Events: make([]*cinema_service.Event, len(modelsEvents))}
+	for i := range modelsEvents {
+		events.Events[i] = &cinema_service.Event{
+			EventID:     modelsEvents[i].ID,
+			Name:        modelsEvents[i].Name,
+			Description: modelsEvents[i].Description,
+			StartDate:   formattedTimestampFromTime(modelsEvents[i].StartDate),
+			EndDate:     formattedTimestampFromTime(modelsEvents[i].EndDate),
+		}
+	}
+
+	return
+}
+
+func (h *CinemaServiceHandler) GetEventScreenings(ctx context.Context,
+	in *cinema_service.GetEventScreeningsRequest) (screenings *cinema_service.Screenings, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	modelsScreenings, err := h.s.GetEventScreenings(ctx, in.EventID, models.ScreeningsFilter{ChainsIDs: chainsIDs})
+	if err != nil {
+		return
+	}
+
+	screenings = screeningsFromModel(modelsScreenings)
 	return
 }
 
 func (h *CinemaServiceHandler) GetScreeningsInCity(ctx context.Context,
 	in *cinema_service.GetScreeningsInCityRequest) (screenings *cinema_service.CityScreenings, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	start, end, err := parsePeriods(in.StartPeriod, in.EndPeriod)
 	if err != nil {
 		return
 	}
 
-	modelsScreenings, err := h.s.GetCityScreenings(ctx, in.CityID, in.MovieID, start, end)
+	filter, err := screeningsFilterFromRequest(in)
+	if err != nil {
+		return
+	}
+
+	modelsScreenings, err := h.s.GetCityScreenings(ctx, in.CityID, in.MovieID, start, end,
+		filter, in.Enrich)
 	if err != nil {
 		return
 	}
@@ -202,33 +427,84 @@ func (h *CinemaServiceHandler) GetScreeningsInCity(ctx context.Context,
 	screenings = &cinema_service.CityScreenings{
 		Screenings: make([]*cinema_service.CityScreening, len(modelsScreenings)),
 	}
+	now := time.Now()
 	for i := range modelsScreenings {
-		screenings.Screenings[i] = &cinema_service.CityScreening{
-			ScreeningID:   modelsScreenings[i].ScreeningID,
-			CinemaID:      modelsScreenings[i].CinemaID,
-			ScreeningType: modelsScreenings[i].ScreeningType,
-			StartTime:     formattedTimestampFromTime(modelsScreenings[i].StartTime),
-			HallID:        modelsScreenings[i].HallID,
-			TicketPrice:   priceFromString(modelsScreenings[i].TicketPrice),
-		}
+		screenings.Screenings[i] = cityScreeningFromModel(&modelsScreenings[i], now)
 	}
 
 	return
 }
 
+func cityScreeningFromModel(screening *models.CityScreening, now time.Time) *cinema_service.CityScreening {
+	return &cinema_service.CityScreening{
+		ScreeningID:   screening.ScreeningID,
+		CinemaID:      screening.CinemaID,
+		ScreeningType: screening.ScreeningType,
+		StartTime:     formattedTimestampFromTime(screening.StartTime),
+		HallID:        screening.HallID,
+		TicketPrice:   priceFromString(screening.TicketPrice),
+		Attributes:    screeningAttributesFromModel(&screening.ScreeningAttributes),
+		Status:        screeningStatusFromModel(&screening.ScreeningStatusInfo),
+		SalesOpenAt:   formattedTimestampFromTime(screening.SalesOpenAt),
+		SalesCloseAt:  formattedTimestampFromTime(screening.SalesCloseAt),
+		Purchasable:   screening.IsPurchasable(screening.Status, now),
+		EventsIDs:     screening.EventsIDs,
+		EndTime:       optionalTimestampFromTime(screening.EndTime),
+		MovieTitle:    screening.MovieTitle,
+	}
+}
+
+func (h *CinemaServiceHandler) GetAlternatives(ctx context.Context,
+	in *cinema_service.GetAlternativesRequest) (res *cinema_service.AlternativeScreenings, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	alternatives, err := h.s.GetAlternatives(ctx, in.ScreeningID, int(in.Limit),
+		models.ScreeningsFilter{ChainsIDs: chainsIDs})
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.AlternativeScreenings{
+		Screenings: make([]*cinema_service.AlternativeScreening, len(alternatives)),
+	}
+	now := time.Now()
+	for i := range alternatives {
+		res.Screenings[i] = &cinema_service.AlternativeScreening{
+			Screening:         cityScreeningFromModel(&alternatives[i].CityScreening, now),
+			Distance:          alternatives[i].Distance,
+			SameScreeningType: alternatives[i].SameScreeningType,
+		}
+	}
+	return
+}
+
 func formattedTimestampFromTime(t time.Time) *cinema_service.Timestamp {
 	return &cinema_service.Timestamp{FormattedTimestamp: t.Format(time.RFC3339)}
 }
 
+func optionalTimestampFromTime(t *time.Time) *cinema_service.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return formattedTimestampFromTime(*t)
+}
+
 func (h *CinemaServiceHandler) GetScreening(ctx context.Context,
 	in *cinema_service.GetScreeningRequest) (screening *cinema_service.GetScreeningResponse, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	if in.Mask != nil && !in.Mask.IsValid(&cinema_service.GetScreeningResponse{}) {
 		return nil, status.Error(codes.InvalidArgument, "invalid mask value")
 	}
 
-	modelsScreening, err := h.s.GetScreening(ctx, in.ScreeningID)
+	modelsScreening, err := h.s.GetScreening(ctx, in.ScreeningID, in.Enrich)
 	if err != nil {
 		return
 	}
@@ -245,10 +521,13 @@ func (h *CinemaServiceHandler) GetScreening(ctx context.Context,
 	}
 
 	if needConfiguration {
-		configuration, err = h.GetHallConfiguration(ctx,
-			&cinema_service.GetHallConfigurationRequest{
-				HallID: modelsScreening.HallID,
-			})
+		req := &cinema_service.GetHallConfigurationRequest{
+			HallID: modelsScreening.HallID,
+		}
+		if in.WithAvailability {
+			req.ScreeningID = &in.ScreeningID
+		}
+		configuration, err = h.GetHallConfiguration(ctx, req)
 		if err != nil {
 			return
 		}
@@ -262,6 +541,14 @@ func (h *CinemaServiceHandler) GetScreening(ctx context.Context,
 		HallID:            modelsScreening.HallID,
 		TicketPrice:       priceFromString(modelsScreening.TicketPrice),
 		HallConfiguration: configuration,
+		Attributes:        screeningAttributesFromModel(&modelsScreening.ScreeningAttributes),
+		Status:            screeningStatusFromModel(&modelsScreening.ScreeningStatusInfo),
+		SalesOpenAt:       formattedTimestampFromTime(modelsScreening.SalesOpenAt),
+		SalesCloseAt:      formattedTimestampFromTime(modelsScreening.SalesCloseAt),
+		Purchasable:       modelsScreening.IsPurchasable(modelsScreening.Status, time.Now()),
+		EventsIDs:         modelsScreening.EventsIDs,
+		EndTime:           optionalTimestampFromTime(modelsScreening.EndTime),
+		MovieTitle:        modelsScreening.MovieTitle,
 	}
 
 	if in.Mask != nil {
@@ -271,9 +558,62 @@ func (h *CinemaServiceHandler) GetScreening(ctx context.Context,
 	return
 }
 
+func (h *CinemaServiceHandler) GetScreeningsByIds(ctx context.Context,
+	in *cinema_service.GetScreeningsByIdsRequest) (res *cinema_service.ScreeningsByIds, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	in.ScreeningsIds = strings.ReplaceAll(in.ScreeningsIds, `"`, "")
+	if err = checkIds(in.ScreeningsIds); err != nil {
+		return
+	}
+
+	ids := make([]int64, 0, strings.Count(in.ScreeningsIds, ",")+1)
+	for _, str := range strings.Split(in.ScreeningsIds, ",") {
+		id, perr := strconv.ParseInt(str, 10, 64)
+		if perr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid screenings_ids value")
+		}
+		ids = append(ids, id)
+	}
+
+	modelsScreenings, missingIDs, err := h.s.GetScreeningsByIds(ctx, ids, in.Enrich)
+	if err != nil {
+		return
+	}
+
+	return &cinema_service.ScreeningsByIds{
+		Screenings: screeningsFromModel(modelsScreenings).Screenings,
+		MissingIds: missingIDs,
+	}, nil
+}
+
+func (h *CinemaServiceHandler) UpdateScreeningStatus(ctx context.Context,
+	in *cinema_service.UpdateScreeningStatusRequest) (_ *emptypb.Empty, err error) {
+	defer h.handleError(&err)
+
+	screeningStatus, ok := screeningStatusFromProto(in.Status)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status value")
+	}
+
+	err = h.s.UpdateScreeningStatus(ctx, models.ScreeningStatusChange{
+		ScreeningID:            in.ScreeningID,
+		Status:                 screeningStatus,
+		Reason:                 strings.TrimSpace(in.Reason),
+		ReplacementScreeningID: in.ReplacementScreeningID,
+	})
+	if err != nil {
+		return
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (h *CinemaServiceHandler) GetCinemasCities(ctx context.Context,
 	_ *emptypb.Empty) (cities *cinema_service.Cities, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	modelsCities, err := h.s.GetCinemasCities(ctx)
 	if err != nil {
@@ -282,10 +622,7 @@ func (h *CinemaServiceHandler) GetCinemasCities(ctx context.Context,
 
 	cities = &cinema_service.Cities{Cities: make([]*cinema_service.City, len(modelsCities))}
 	for i := range modelsCities {
-		cities.Cities[i] = &cinema_service.City{
-			CityID: modelsCities[i].ID,
-			Name:   modelsCities[i].Name,
-		}
+		cities.Cities[i] = cityFromModel(&modelsCities[i])
 	}
 
 	return
@@ -295,18 +632,299 @@ func (h *CinemaServiceHandler) GetHallConfiguration(ctx context.Context,
 	in *cinema_service.GetHallConfigurationRequest) (configuration *cinema_service.HallConfiguration, err error) {
 	defer h.handleError(&err)
 
-	places, err := h.s.GetHallConfiguraion(ctx, in.HallID)
+	var modelsConfiguration models.HallConfiguration
+	if in.ScreeningID != nil {
+		modelsConfiguration, err = h.s.GetHallConfigurationWithAvailability(ctx, in.HallID, in.GetScreeningID())
+	} else {
+		modelsConfiguration, err = h.s.GetHallConfiguraion(ctx, in.HallID)
+	}
+	if err != nil {
+		return
+	}
+
+	configuration = hallConfigurationFromModel(&modelsConfiguration)
+	return
+}
+
+func (h *CinemaServiceHandler) ListChains(ctx context.Context,
+	in *cinema_service.ListChainsRequest) (chains *cinema_service.Chains, err error) {
+	defer h.handleError(&err)
+
+	modelsChains, err := h.s.ListChains(ctx, in.CityID)
+	if err != nil {
+		return
+	}
+
+	chains = &cinema_service.Chains{Chains: make([]*cinema_service.Chain, len(modelsChains))}
+	for i := range modelsChains {
+		chains.Chains[i] = &cinema_service.Chain{
+			ChainID: modelsChains[i].ID,
+			Name:    modelsChains[i].Name,
+			Brands:  modelsChains[i].Brands,
+		}
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) Search(ctx context.Context,
+	in *cinema_service.SearchRequest) (res *cinema_service.SearchResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	results, err := h.s.Search(ctx, in.Query, in.CityID, int(in.Limit))
 	if err != nil {
 		return
 	}
 
-	configuration = hallConfigurationFromModel(places)
+	res = &cinema_service.SearchResponse{Results: make([]*cinema_service.SearchResult, len(results))}
+	for i := range results {
+		res.Results[i] = &cinema_service.SearchResult{
+			Type:             searchResultTypeFromModel(results[i].Type),
+			Id:               results[i].ID,
+			Name:             results[i].Name,
+			Address:          results[i].Address,
+			CityID:           results[i].CityID,
+			Score:            results[i].Score,
+			NameHighlight:    results[i].NameHighlight,
+			AddressHighlight: results[i].AddressHighlight,
+		}
+	}
 	return
 }
 
+func (h *CinemaServiceHandler) AutocompleteCities(ctx context.Context,
+	in *cinema_service.AutocompleteCitiesRequest) (res *cinema_service.CitiesSuggestions, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	cities, err := h.s.AutocompleteCities(ctx, in.Prefix, int(in.Limit))
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.CitiesSuggestions{Cities: make([]*cinema_service.CitySuggestion, len(cities))}
+	for i := range cities {
+		res.Cities[i] = &cinema_service.CitySuggestion{
+			CityID:     cities[i].ID,
+			Name:       cities[i].Name,
+			HasCinemas: cities[i].HasCinemas,
+		}
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) GetCinemasInBounds(ctx context.Context,
+	in *cinema_service.GetCinemasInBoundsRequest) (res *cinema_service.CinemasInBounds, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	bounds := models.GeoBounds{
+		MinLatitude:  in.MinLat,
+		MinLongitude: in.MinLon,
+		MaxLatitude:  in.MaxLat,
+		MaxLongitude: in.MaxLon,
+	}
+	filter := models.CinemasFilter{Amenities: parseNames(in.GetAmenities()), ChainsIDs: chainsIDs}
+	inBounds, err := h.s.GetCinemasInBounds(ctx, bounds, in.Zoom, filter)
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.CinemasInBounds{
+		Cinemas:  make([]*cinema_service.Cinema, len(inBounds.Cinemas)),
+		Clusters: make([]*cinema_service.CinemasCluster, len(inBounds.Clusters)),
+	}
+	for i := range inBounds.Cinemas {
+		res.Cinemas[i] = cinemaFromModels(&inBounds.Cinemas[i])
+	}
+	for i := range inBounds.Clusters {
+		res.Clusters[i] = &cinema_service.CinemasCluster{
+			Centroid: &cinema_service.Coordinates{
+				Latityde:  inBounds.Clusters[i].Centroid.Latityde,
+				Longitude: inBounds.Clusters[i].Centroid.Longitude,
+			},
+			CinemasCount: inBounds.Clusters[i].CinemasCount,
+		}
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) ResolveCity(ctx context.Context,
+	in *cinema_service.ResolveCityRequest) (res *cinema_service.ResolveCityResponse, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	city, err := h.s.ResolveCity(ctx, models.GeoPoint{Latityde: in.Lat, Longitude: in.Lon})
+	if err != nil {
+		return
+	}
+
+	return &cinema_service.ResolveCityResponse{
+		City:       cityFromModel(&city.City),
+		ByBoundary: city.ByBoundary,
+	}, nil
+}
+
+func (h *CinemaServiceHandler) ListCountries(ctx context.Context,
+	in *emptypb.Empty) (res *cinema_service.Countries, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	countries, err := h.s.ListCountries(ctx)
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.Countries{Countries: make([]*cinema_service.Country, len(countries))}
+	for i := range countries {
+		res.Countries[i] = &cinema_service.Country{
+			CountryID: countries[i].ID,
+			Name:      countries[i].Name,
+			Code:      countries[i].Code,
+			Currency:  countries[i].Currency,
+			TimeZone:  countries[i].TimeZone,
+			Locale:    countries[i].Locale,
+		}
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) ListRegions(ctx context.Context,
+	in *cinema_service.ListRegionsRequest) (res *cinema_service.Regions, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	regions, err := h.s.ListRegions(ctx, in.CountryID)
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.Regions{Regions: make([]*cinema_service.Region, len(regions))}
+	for i := range regions {
+		res.Regions[i] = &cinema_service.Region{
+			RegionID:  regions[i].ID,
+			CountryID: regions[i].CountryID,
+			Name:      regions[i].Name,
+		}
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) GetRegionCities(ctx context.Context,
+	in *cinema_service.GetRegionCitiesRequest) (res *cinema_service.Cities, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	cities, err := h.s.GetRegionCities(ctx, in.RegionID)
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.Cities{Cities: make([]*cinema_service.City, len(cities))}
+	for i := range cities {
+		res.Cities[i] = cityFromModel(&cities[i])
+	}
+	return
+}
+
+func (h *CinemaServiceHandler) GetNowShowing(ctx context.Context,
+	in *cinema_service.GetCityMoviesRequest) (res *cinema_service.CityMovies, err error) {
+	defer h.handleError(&err)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	movies, err := h.s.GetNowShowing(ctx, in.CityID, in.Days, models.ScreeningsFilter{ChainsIDs: chainsIDs})
+	if err != nil {
+		return
+	}
+	return cityMoviesFromModel(movies), nil
+}
+
+func (h *CinemaServiceHandler) GetComingSoon(ctx context.Context,
+	in *cinema_service.GetCityMoviesRequest) (res *cinema_service.CityMovies, err error) {
+	defer h.handleError(&err)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	movies, err := h.s.GetComingSoon(ctx, in.CityID, in.Days, models.ScreeningsFilter{ChainsIDs: chainsIDs})
+	if err != nil {
+		return
+	}
+	return cityMoviesFromModel(movies), nil
+}
+
+func (h *CinemaServiceHandler) GetCinemaUpcoming(ctx context.Context,
+	in *cinema_service.GetCinemaUpcomingRequest) (res *cinema_service.MoviesUpcomingScreenings, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	chainsIDs, err := parseIds(in.GetChainsIds())
+	if err != nil {
+		return
+	}
+
+	movies, err := h.s.GetCinemaUpcoming(ctx, in.CinemaID, int(in.PerMovieLimit),
+		models.ScreeningsFilter{ChainsIDs: chainsIDs})
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.MoviesUpcomingScreenings{
+		Movies: make([]*cinema_service.MovieUpcomingScreenings, len(movies)),
+	}
+	for i := range movies {
+		res.Movies[i] = &cinema_service.MovieUpcomingScreenings{
+			MovieID:    movies[i].MovieID,
+			Screenings: screeningsFromModel(movies[i].Screenings).Screenings,
+		}
+	}
+	return
+}
+
+func cityMoviesFromModel(movies []models.CityMovie) *cinema_service.CityMovies {
+	converted := &cinema_service.CityMovies{Movies: make([]*cinema_service.CityMovie, len(movies))}
+	for i := range movies {
+		converted.Movies[i] = &cinema_service.CityMovie{
+			MovieID:            movies[i].MovieID,
+			FirstScreeningTime: formattedTimestampFromTime(movies[i].FirstScreeningTime),
+		}
+	}
+	return converted
+}
+
+func cityFromModel(city *models.City) *cinema_service.City {
+	return &cinema_service.City{
+		CityID:   city.ID,
+		Name:     city.Name,
+		RegionID: city.RegionID,
+		Currency: city.Currency,
+		TimeZone: city.TimeZone,
+		Locale:   city.Locale,
+	}
+}
+
+func searchResultTypeFromModel(t models.SearchResultType) cinema_service.SearchResultType {
+	if t == models.SearchResultTypeCinema {
+		return cinema_service.SearchResultType_SEARCH_RESULT_TYPE_CINEMA
+	}
+	return cinema_service.SearchResultType_SEARCH_RESULT_TYPE_CITY
+}
+
 func (h *CinemaServiceHandler) GetCinema(ctx context.Context,
 	in *cinema_service.GetCinemaRequest) (cinema *cinema_service.Cinema, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	modelsCinema, err := h.s.GetCinema(ctx, in.CinemaID)
 	if err != nil {
@@ -317,7 +935,49 @@ func (h *CinemaServiceHandler) GetCinema(ctx context.Context,
 	return
 }
 
+func (h *CinemaServiceHandler) GetCinemasByIds(ctx context.Context,
+	in *cinema_service.GetCinemasByIdsRequest) (res *cinema_service.CinemasByIds, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	ids, err := parseIds(in.CinemasIds)
+	if err != nil {
+		return
+	}
+
+	modelsCinemas, missingIDs, err := h.s.GetCinemasByIds(ctx, ids)
+	if err != nil {
+		return
+	}
+
+	res = &cinema_service.CinemasByIds{
+		Cinemas:    make([]*cinema_service.Cinema, len(modelsCinemas)),
+		MissingIds: missingIDs,
+	}
+	for i := range modelsCinemas {
+		res.Cinemas[i] = cinemaFromModels(&modelsCinemas[i])
+	}
+	return
+}
+
 func cinemaFromModels(cinema *models.Cinema) *cinema_service.Cinema {
+	openingHours := make([]*cinema_service.OpeningHours, len(cinema.OpeningHours))
+	for i, hours := range cinema.OpeningHours {
+		openingHours[i] = &cinema_service.OpeningHours{
+			Weekday:  int32(hours.Weekday),
+			OpensAt:  fmt.Sprintf("%02d:%02d", hours.OpensAt/60, hours.OpensAt%60),
+			ClosesAt: fmt.Sprintf("%02d:%02d", hours.ClosesAt/60, hours.ClosesAt%60),
+		}
+	}
+	closures := make([]*cinema_service.CinemaClosure, len(cinema.Closures))
+	for i, closure := range cinema.Closures {
+		closures[i] = &cinema_service.CinemaClosure{
+			StartTime: formattedTimestampFromTime(closure.StartTime),
+			EndTime:   formattedTimestampFromTime(closure.EndTime),
+			Reason:    closure.Reason,
+		}
+	}
+
 	return &cinema_service.Cinema{
 		CinemaID: cinema.ID,
 		Name:     cinema.Name,
@@ -326,12 +986,82 @@ func cinemaFromModels(cinema *models.Cinema) *cinema_service.Cinema {
 			Longitude: cinema.Coordinates.Longitude,
 			Latityde:  cinema.Coordinates.Latityde,
 		},
+		TimeZone:     cinema.TimeZone,
+		OpeningHours: openingHours,
+		Closures:     closures,
+		OpenNow:      cinema.IsOpen(time.Now()),
+		Phone:        cinema.Phone,
+		Website:      cinema.Website,
+		Amenities:    cinema.Amenities,
+		PhotosUrls:   cinema.PhotosURLs,
+		ChainID:      cinema.ChainID,
+		Chain:        cinema.Chain,
+		Brand:        cinema.Brand,
+	}
+}
+
+func (h *CinemaServiceHandler) UploadCinemaPhoto(ctx context.Context,
+	in *cinema_service.UploadCinemaPhotoRequest) (res *cinema_service.UploadCinemaPhotoResponse, err error) {
+	defer h.handleError(&err)
+
+	url, err := h.s.UploadCinemaPhoto(ctx, in.CinemaID, in.Photo)
+	if err != nil {
+		return
+	}
+
+	return &cinema_service.UploadCinemaPhotoResponse{PhotoUrl: url}, nil
+}
+
+func (h *CinemaServiceHandler) CreateScreening(ctx context.Context,
+	in *cinema_service.CreateScreeningRequest) (res *cinema_service.CreateScreeningResponse, err error) {
+	defer h.handleError(&err)
+
+	if in.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time mustn't be empty")
+	}
+	start, err := time.Parse(time.RFC3339, in.StartTime.FormattedTimestamp)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start_time value, it must be RFC3339 layout value")
+	}
+	if in.TicketPrice.GetValue() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticket_price must be greater than zero")
+	}
+
+	screening := models.NewScreening{
+		StartTime:       start,
+		TicketPrice:     priceToString(in.TicketPrice),
+		MovieID:         in.MovieID,
+		HallID:          in.HallID,
+		ScreeningTypeID: in.ScreeningTypeID,
+	}
+	if in.Attributes != nil {
+		screening.ScreeningAttributes = models.ScreeningAttributes{
+			SpokenLanguage:    strings.ToLower(strings.TrimSpace(in.Attributes.SpokenLanguage)),
+			SubtitlesLanguage: strings.ToLower(strings.TrimSpace(in.Attributes.SubtitlesLanguage)),
+			OriginalLanguage:  in.Attributes.OriginalLanguage,
+			AudioDescription:  in.Attributes.AudioDescription,
+			ClosedCaptions:    in.Attributes.ClosedCaptions,
+			AgeRating:         strings.TrimSpace(in.Attributes.AgeRating),
+			Relaxed:           in.Attributes.Relaxed,
+		}
+	}
+	// if the duration is not specified, the end time is calculated from the movie runtime
+	if in.Duration > 0 {
+		screening.EndTime = start.Add(time.Duration(in.Duration) * time.Minute)
+	}
+
+	id, err := h.s.CreateScreening(ctx, screening)
+	if err != nil {
+		return
 	}
+
+	return &cinema_service.CreateScreeningResponse{ScreeningID: id}, nil
 }
 
 func (h *CinemaServiceHandler) GetHalls(ctx context.Context,
 	in *cinema_service.GetHallsRequest) (halls *cinema_service.Halls, err error) {
 	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
 
 	in.HallsIds = strings.ReplaceAll(in.HallsIds, `"`, "")
 	if err = checkIds(in.HallsIds); err != nil {
@@ -348,14 +1078,107 @@ func (h *CinemaServiceHandler) GetHalls(ctx context.Context,
 	}
 
 	for i := range modelsHalls {
-		halls.Halls[i] = &cinema_service.Hall{
-			HallID:   modelsHalls[i].ID,
-			HallSize: modelsHalls[i].Size,
-			Name:     modelsHalls[i].Name,
-			Type:     modelsHalls[i].Type,
+		halls.Halls[i] = hallFromModel(&modelsHalls[i])
+	}
+
+	return
+}
+
+func (h *CinemaServiceHandler) GetCinemaHalls(ctx context.Context,
+	in *cinema_service.GetCinemaHalls) (halls *cinema_service.Halls, err error) {
+	defer h.handleError(&err)
+	ctx = h.locales.withLocale(ctx)
+
+	modelsHalls, err := h.s.GetCinemaHalls(ctx, in.CinemaID)
+	if err != nil {
+		return
+	}
+
+	halls = &cinema_service.Halls{
+		Halls: make([]*cinema_service.Hall, len(modelsHalls)),
+	}
+	for i := range modelsHalls {
+		halls.Halls[i] = hallFromModel(&modelsHalls[i])
+	}
+	return
+}
+
+func hallFromModel(hall *models.Hall) *cinema_service.Hall {
+	categories := make([]*cinema_service.HallCategoryCapacity, len(hall.Categories))
+	for i := range hall.Categories {
+		categories[i] = &cinema_service.HallCategoryCapacity{
+			Category: hall.Categories[i].Category,
+			Capacity: hall.Categories[i].Capacity,
 		}
 	}
 
+	converted := &cinema_service.Hall{
+		HallID:         hall.ID,
+		HallSize:       hall.Size,
+		Name:           hall.Name,
+		Type:           hall.Type,
+		AccessibleSize: hall.AccessibleSize,
+		Categories:     categories,
+		Capabilities:   hall.Capabilities,
+		CinemaID:       hall.CinemaID,
+	}
+	if hall.Maintenance != nil {
+		converted.Availability = cinema_service.HallAvailability_HALL_AVAILABILITY_UNDER_MAINTENANCE
+		converted.CurrentMaintenance = hallMaintenanceWindowFromModel(hall.Maintenance)
+	}
+
+	return converted
+}
+
+func hallMaintenanceWindowFromModel(window *models.HallMaintenanceWindow) *cinema_service.HallMaintenanceWindow {
+	return &cinema_service.HallMaintenanceWindow{
+		MaintenanceWindowID:   window.ID,
+		HallID:                window.HallID,
+		StartTime:             formattedTimestampFromTime(window.StartTime),
+		EndTime:               formattedTimestampFromTime(window.EndTime),
+		Reason:                window.Reason,
+		AffectedScreeningsIDs: window.AffectedScreeningsIDs,
+	}
+}
+
+func (h *CinemaServiceHandler) CreateHallMaintenanceWindow(ctx context.Context,
+	in *cinema_service.CreateHallMaintenanceWindowRequest) (window *cinema_service.HallMaintenanceWindow, err error) {
+	defer h.handleError(&err)
+
+	start, end, err := parsePeriods(in.StartTime, in.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	modelsWindow, err := h.s.CreateHallMaintenanceWindow(ctx, models.HallMaintenanceWindow{
+		StartTime: start,
+		EndTime:   end,
+		Reason:    strings.TrimSpace(in.Reason),
+		HallID:    in.HallID,
+	})
+	if err != nil {
+		return
+	}
+
+	window = hallMaintenanceWindowFromModel(&modelsWindow)
+	return
+}
+
+func (h *CinemaServiceHandler) ListHallMaintenanceWindows(ctx context.Context,
+	in *cinema_service.ListHallMaintenanceWindowsRequest) (windows *cinema_service.HallMaintenanceWindows, err error) {
+	defer h.handleError(&err)
+
+	modelsWindows, err := h.s.GetHallMaintenanceWindows(ctx, in.HallID)
+	if err != nil {
+		return
+	}
+
+	windows = &cinema_service.HallMaintenanceWindows{
+		Windows: make([]*cinema_service.HallMaintenanceWindow, len(modelsWindows)),
+	}
+	for i := range modelsWindows {
+		windows.Windows[i] = hallMaintenanceWindowFromModel(&modelsWindows[i])
+	}
 	return
 }
 
@@ -396,6 +1219,10 @@ func priceFromString(n string) *cinema_service.Price {
 	return &cinema_service.Price{Value: int32(units)*100 + int32(nanos)}
 }
 
+func priceToString(price *cinema_service.Price) string {
+	return fmt.Sprintf("%d.%02d", price.GetValue()/100, price.GetValue()%100)
+}
+
 func (h *CinemaServiceHandler) handleError(err *error) {
 	if err == nil || *err == nil {
 		return
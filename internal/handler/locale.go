@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	localeMetadataKey         = "locale"
+	acceptLanguageMetadataKey = "accept-language"
+)
+
+// LocaleResolver selects the locale of the names translations from the request metadata.
+type LocaleResolver struct {
+	defaultLocale    string
+	supportedLocales []string
+}
+
+func NewLocaleResolver(defaultLocale string, supportedLocales []string) *LocaleResolver {
+	supported := make([]string, len(supportedLocales))
+	for i := range supportedLocales {
+		supported[i] = strings.ToLower(strings.TrimSpace(supportedLocales[i]))
+	}
+
+	return &LocaleResolver{
+		defaultLocale:    strings.ToLower(defaultLocale),
+		supportedLocales: supported,
+	}
+}
+
+// withLocale returns the context with the locale from the locale metadata key or from the Accept-Language header,
+// if the requested locale is not supported, the default locale is used.
+func (r *LocaleResolver) withLocale(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return models.ContextWithLocale(ctx, r.resolve(md.Get(localeMetadataKey), md.Get(acceptLanguageMetadataKey)))
+}
+
+// withRequestLocale is the same as withLocale for the plain http requests,
+// the locale is taken from the locale query parameter or from the Accept-Language header.
+func (r *LocaleResolver) withRequestLocale(ctx context.Context, req *http.Request) context.Context {
+	return models.ContextWithLocale(ctx,
+		r.resolve(req.URL.Query()[localeMetadataKey], req.Header.Values("Accept-Language")))
+}
+
+func (r *LocaleResolver) resolve(locales, acceptLanguageHeaders []string) string {
+	if len(locales) > 0 {
+		if locale, ok := r.match(locales[0]); ok {
+			return locale
+		}
+	}
+
+	for _, header := range acceptLanguageHeaders {
+		for _, tag := range parseAcceptLanguage(header) {
+			if locale, ok := r.match(tag); ok {
+				return locale
+			}
+		}
+	}
+
+	return r.defaultLocale
+}
+
+// match returns the supported locale for the language tag, for example en for en-US.
+func (r *LocaleResolver) match(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if slices.Contains(r.supportedLocales, tag) {
+		return tag, true
+	}
+
+	primary, _, _ := strings.Cut(tag, "-")
+	if slices.Contains(r.supportedLocales, primary) {
+		return primary, true
+	}
+	return "", false
+}
+
+// parseAcceptLanguage returns the language tags from the Accept-Language header value ordered by the quality.
+func parseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		// q=0 means that the language is not acceptable
+		if quality <= 0 {
+			continue
+		}
+		tags = append(tags, weightedTag{tag: tag, quality: quality})
+	}
+
+	slices.SortStableFunc(tags, func(a, b weightedTag) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		}
+		return 0
+	})
+
+	result := make([]string, len(tags))
+	for i := range tags {
+		result[i] = tags[i].tag
+	}
+	return result
+}
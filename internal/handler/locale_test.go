@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   string
+		expected []string
+	}{
+		{name: "empty", header: "", expected: []string{}},
+		{name: "single tag", header: "ru", expected: []string{"ru"}},
+		{name: "ordered by quality", header: "en;q=0.5, ru, de;q=0.8", expected: []string{"ru", "de", "en"}},
+		{name: "same quality keeps order", header: "fr, en-US, ru;q=0.9, de;q=0.9", expected: []string{"fr", "en-US", "ru", "de"}},
+		{name: "wildcard skipped", header: "*, en;q=0.1", expected: []string{"en"}},
+		{name: "not acceptable skipped", header: "en;q=0, ru;q=0.3", expected: []string{"ru"}},
+		{name: "invalid quality is the highest", header: "en;q=0.5, ru;q=abc", expected: []string{"ru", "en"}},
+		{name: "spaces and empty parts", header: " en-GB ; q=0.7 ,, ru ", expected: []string{"ru", "en-GB"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tags := parseAcceptLanguage(tc.header); !slices.Equal(tags, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, tags)
+			}
+		})
+	}
+}
+
+func TestLocaleResolverResolve(t *testing.T) {
+	resolver := NewLocaleResolver("RU", []string{" en ", "DE"})
+
+	testCases := []struct {
+		name                  string
+		locales               []string
+		acceptLanguageHeaders []string
+		expected              string
+	}{
+		{name: "nothing requested", expected: "ru"},
+		{name: "locale metadata", locales: []string{"EN"}, expected: "en"},
+		{name: "locale metadata has priority", locales: []string{"de"}, acceptLanguageHeaders: []string{"en"}, expected: "de"},
+		{name: "unsupported locale metadata falls back to the header", locales: []string{"fr"},
+			acceptLanguageHeaders: []string{"en"}, expected: "en"},
+		{name: "region is matched by the primary language", acceptLanguageHeaders: []string{"en-US"}, expected: "en"},
+		{name: "first supported by quality", acceptLanguageHeaders: []string{"fr, en;q=0.5, de;q=0.8"}, expected: "de"},
+		{name: "several headers", acceptLanguageHeaders: []string{"fr", "en"}, expected: "en"},
+		{name: "unsupported falls back to the default", acceptLanguageHeaders: []string{"fr, es"}, expected: "ru"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if locale := resolver.resolve(tc.locales, tc.acceptLanguageHeaders); locale != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, locale)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package mediastorage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Config struct {
+	// Directory where the media files are stored.
+	BasePath string `yaml:"base_path" env:"MEDIA_STORAGE_BASE_PATH"`
+	// Public url prefix of the stored files, for example https://static.example.com/media
+	BaseURL string `yaml:"base_url" env:"MEDIA_STORAGE_BASE_URL"`
+}
+
+// LocalStorage stores media files in the local filesystem, the files must be served by the static server.
+type LocalStorage struct {
+	basePath string
+	baseURL  string
+}
+
+func NewLocalStorage(cfg Config) (*LocalStorage, error) {
+	if err := os.MkdirAll(cfg.BasePath, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		basePath: cfg.BasePath,
+		baseURL:  strings.TrimSuffix(cfg.BaseURL, "/"),
+	}, nil
+}
+
+// SaveFile saves the file with the specified name and returns its public url.
+func (s *LocalStorage) SaveFile(_ context.Context, name string, data []byte) (string, error) {
+	path, err := s.filePath(name)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+
+	return url.JoinPath(s.baseURL, filepath.ToSlash(filepath.Clean(name)))
+}
+
+// DeleteFile removes the file with the specified name, missing file isn't an error.
+func (s *LocalStorage) DeleteFile(_ context.Context, name string) error {
+	path, err := s.filePath(name)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) filePath(name string) (string, error) {
+	if !filepath.IsLocal(name) {
+		return "", errors.New("invalid file name")
+	}
+	return filepath.Join(s.basePath, name), nil
+}
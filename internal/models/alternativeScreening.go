@@ -0,0 +1,8 @@
+package models
+
+type AlternativeScreening struct {
+	CityScreening
+	// Distance between the cinemas of the screening and the alternative in meters.
+	Distance          float64 `db:"distance"`
+	SameScreeningType bool    `db:"same_screening_type"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// Chain is the cinema chain, chain cinemas may be operated under different brands.
+type Chain struct {
+	Name   string   `json:"name" db:"name"`
+	Brands []string `json:"brands" db:"-"`
+	ID     int32    `json:"id" db:"id"`
+}
@@ -1,8 +1,34 @@
 package models
 
+import "strings"
+
 type Cinema struct {
 	Name        string   `json:"name" db:"name"`
 	Address     string   `json:"address" db:"address"`
 	Coordinates GeoPoint `json:"coordinates" db:"coordinates"`
 	ID          int32    `json:"id" db:"id"`
+	// IANA time zone name, for example Europe/Moscow, opening hours are in this time zone.
+	TimeZone     string         `json:"time_zone" db:"time_zone"`
+	OpeningHours []OpeningHours `json:"opening_hours" db:"-"`
+	// Current and upcoming closures.
+	Closures []CinemaClosure `json:"closures" db:"-"`
+	Phone    string          `json:"phone" db:"phone"`
+	Website  string          `json:"website" db:"website"`
+	// Amenity tags, for example parking, food_court, wheelchair_access.
+	Amenities  []string `json:"amenities" db:"-"`
+	PhotosURLs []string `json:"photos_urls" db:"-"`
+	// 0 if the cinema doesn't belong to any chain.
+	ChainID int32  `json:"chain_id" db:"chain_id"`
+	Chain   string `json:"chain" db:"chain"`
+	Brand   string `json:"brand" db:"brand"`
+}
+
+// HasAmenity returns true if the cinema has the amenity, tags are compared case insensitively.
+func (c *Cinema) HasAmenity(amenity string) bool {
+	for _, a := range c.Amenities {
+		if strings.EqualFold(a, amenity) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,95 @@
+package models
+
+import "time"
+
+// OpeningHours is the cinema working period on the weekday.
+// If ClosesAt is not greater than OpensAt, the cinema closes after midnight.
+type OpeningHours struct {
+	Weekday time.Weekday `json:"weekday" db:"weekday"`
+	// Minutes since midnight in the cinema time zone.
+	OpensAt  int32 `json:"opens_at" db:"opens_at"`
+	ClosesAt int32 `json:"closes_at" db:"closes_at"`
+}
+
+// CinemaClosure is the exceptional closure of the cinema, for example holiday or renovation.
+type CinemaClosure struct {
+	StartTime time.Time `json:"start_time" db:"start_time"`
+	EndTime   time.Time `json:"end_time" db:"end_time"`
+	Reason    string    `json:"reason" db:"reason"`
+}
+
+// IsOpen returns true if the cinema is open at the specified time.
+// Cinema without opening hours is considered always open, except closures.
+func (c *Cinema) IsOpen(t time.Time) bool {
+	return c.IsOpenDuring(t, t)
+}
+
+// IsOpenDuring returns true if the cinema is open from the start to the end,
+// the period must not intersect closures.
+func (c *Cinema) IsOpenDuring(start, end time.Time) bool {
+	for _, closure := range c.Closures {
+		if closure.intersects(start, end) {
+			return false
+		}
+	}
+	if len(c.OpeningHours) == 0 {
+		return true
+	}
+
+	loc, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	// the period may continue in the next opening hours, for example if the cinema is open around the clock
+	for t := start.In(loc); ; {
+		closes, ok := c.closesAfter(t)
+		if !ok {
+			return false
+		}
+		if !end.After(closes) {
+			return true
+		}
+		if !closes.After(t) {
+			return false
+		}
+		t = closes
+	}
+}
+
+// closesAfter returns the latest closing time of the opening hours periods containing the time.
+func (c *Cinema) closesAfter(t time.Time) (closes time.Time, ok bool) {
+	for _, hours := range c.OpeningHours {
+		opens, periodCloses := hours.period(t)
+		if !t.Before(opens) && !t.After(periodCloses) && (!ok || periodCloses.After(closes)) {
+			closes, ok = periodCloses, true
+		}
+	}
+	return
+}
+
+// period returns the opening hours period that may contain the specified time,
+// the period starts on the same day or on the previous day, if the cinema closes after midnight.
+func (h OpeningHours) period(t time.Time) (opens, closes time.Time) {
+	daysAgo := (int(t.Weekday()) - int(h.Weekday) + 7) % 7
+	overnight := h.ClosesAt <= h.OpensAt
+	if overnight && daysAgo > 1 || !overnight && daysAgo > 0 {
+		return time.Time{}, time.Time{}
+	}
+
+	// minutes are added to the wall clock, so the period is right on the daylight saving time transition days
+	year, month, day := t.Date()
+	day -= daysAgo
+	opens = time.Date(year, month, day, 0, int(h.OpensAt), 0, 0, t.Location())
+	if overnight {
+		day++
+	}
+	closes = time.Date(year, month, day, 0, int(h.ClosesAt), 0, 0, t.Location())
+	return opens, closes
+}
+
+func (c CinemaClosure) intersects(start, end time.Time) bool {
+	if start.Equal(end) {
+		return !start.Before(c.StartTime) && start.Before(c.EndTime)
+	}
+	return start.Before(c.EndTime) && end.After(c.StartTime)
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestCinemaIsOpenDuring(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.October, day, hour, minute, 0, 0, berlin)
+	}
+	// 2026-10-16 is Friday
+	const friday, saturday, sunday = 16, 17, 18
+
+	fridayOvernight := []OpeningHours{{Weekday: time.Friday, OpensAt: 18 * 60, ClosesAt: 2 * 60}}
+	aroundTheClock := []OpeningHours{
+		{Weekday: time.Friday, OpensAt: 0, ClosesAt: 0},
+		{Weekday: time.Saturday, OpensAt: 0, ClosesAt: 0},
+	}
+
+	testCases := []struct {
+		name       string
+		cinema     Cinema
+		start, end time.Time
+		expected   bool
+	}{
+		{
+			name:     "without opening hours",
+			cinema:   Cinema{TimeZone: "Europe/Berlin"},
+			start:    at(friday, 4, 0),
+			end:      at(friday, 6, 0),
+			expected: true,
+		},
+		{
+			name:     "overnight hours before midnight",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(friday, 20, 0),
+			end:      at(friday, 22, 0),
+			expected: true,
+		},
+		{
+			name:     "overnight hours after midnight on the next weekday",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(saturday, 1, 0),
+			end:      at(saturday, 1, 0),
+			expected: true,
+		},
+		{
+			name:     "overnight hours crossing midnight",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(friday, 23, 0),
+			end:      at(saturday, 1, 30),
+			expected: true,
+		},
+		{
+			name:     "overnight hours after closing",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(friday, 23, 0),
+			end:      at(saturday, 2, 30),
+			expected: false,
+		},
+		{
+			name:     "overnight hours early morning of the same weekday",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(friday, 1, 0),
+			end:      at(friday, 1, 0),
+			expected: false,
+		},
+		{
+			name:     "overnight hours before opening",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight},
+			start:    at(friday, 17, 0),
+			end:      at(friday, 19, 0),
+			expected: false,
+		},
+		{
+			name: "overnight hours from saturday to sunday",
+			cinema: Cinema{TimeZone: "Europe/Berlin",
+				OpeningHours: []OpeningHours{{Weekday: time.Saturday, OpensAt: 20 * 60, ClosesAt: 60}}},
+			start:    at(sunday, 0, 30),
+			end:      at(sunday, 0, 30),
+			expected: true,
+		},
+		{
+			name:     "around the clock",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: aroundTheClock},
+			start:    at(friday, 3, 0),
+			end:      at(friday, 5, 0),
+			expected: true,
+		},
+		{
+			name:     "around the clock crossing midnight",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: aroundTheClock},
+			start:    at(friday, 23, 0),
+			end:      at(saturday, 1, 0),
+			expected: true,
+		},
+		{
+			name:     "around the clock crossing midnight into the closed day",
+			cinema:   Cinema{TimeZone: "Europe/Berlin", OpeningHours: aroundTheClock},
+			start:    at(saturday, 23, 0),
+			end:      at(sunday, 1, 0),
+			expected: false,
+		},
+		{
+			name: "whole day till 24:00",
+			cinema: Cinema{TimeZone: "Europe/Berlin",
+				OpeningHours: []OpeningHours{{Weekday: time.Friday, OpensAt: 0, ClosesAt: 24 * 60}}},
+			start:    at(friday, 22, 0),
+			end:      at(friday, 23, 59),
+			expected: true,
+		},
+		{
+			name: "hours are in the cinema time zone",
+			cinema: Cinema{TimeZone: "Europe/Berlin",
+				OpeningHours: []OpeningHours{{Weekday: time.Friday, OpensAt: 10 * 60, ClosesAt: 12 * 60}}},
+			// 10:30 in Berlin
+			start:    time.Date(2026, time.October, friday, 8, 30, 0, 0, time.UTC),
+			end:      time.Date(2026, time.October, friday, 8, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "unknown time zone falls back to UTC",
+			cinema: Cinema{TimeZone: "Unknown/Zone",
+				OpeningHours: []OpeningHours{{Weekday: time.Friday, OpensAt: 10 * 60, ClosesAt: 12 * 60}}},
+			start:    time.Date(2026, time.October, friday, 10, 30, 0, 0, time.UTC),
+			end:      time.Date(2026, time.October, friday, 11, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "closure inside the opening hours",
+			cinema: Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight,
+				Closures: []CinemaClosure{{StartTime: at(friday, 20, 0), EndTime: at(friday, 22, 0)}}},
+			start:    at(friday, 21, 0),
+			end:      at(friday, 23, 0),
+			expected: false,
+		},
+		{
+			name: "screening ends when the closure starts",
+			cinema: Cinema{TimeZone: "Europe/Berlin", OpeningHours: fridayOvernight,
+				Closures: []CinemaClosure{{StartTime: at(friday, 20, 0), EndTime: at(friday, 22, 0)}}},
+			start:    at(friday, 18, 0),
+			end:      at(friday, 20, 0),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if open := tc.cinema.IsOpenDuring(tc.start, tc.end); open != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, open)
+			}
+		})
+	}
+}
+
+func TestCinemaIsOpenOnDaylightSavingTimeTransition(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// clocks are moved from 02:00 to 03:00 on 2026-03-29 and from 03:00 to 02:00 on 2026-10-25, both are sundays
+	cinema := Cinema{
+		TimeZone:     "Europe/Berlin",
+		OpeningHours: []OpeningHours{{Weekday: time.Sunday, OpensAt: 10 * 60, ClosesAt: 23 * 60}},
+	}
+
+	testCases := []struct {
+		name     string
+		t        time.Time
+		expected bool
+	}{
+		{name: "after opening on the spring transition", t: time.Date(2026, time.March, 29, 10, 30, 0, 0, berlin), expected: true},
+		{name: "before opening on the spring transition", t: time.Date(2026, time.March, 29, 9, 30, 0, 0, berlin), expected: false},
+		{name: "before closing on the spring transition", t: time.Date(2026, time.March, 29, 22, 30, 0, 0, berlin), expected: true},
+		{name: "after opening on the autumn transition", t: time.Date(2026, time.October, 25, 10, 0, 0, 0, berlin), expected: true},
+		{name: "before opening on the autumn transition", t: time.Date(2026, time.October, 25, 9, 30, 0, 0, berlin), expected: false},
+		{name: "after closing on the autumn transition", t: time.Date(2026, time.October, 25, 23, 30, 0, 0, berlin), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if open := cinema.IsOpen(tc.t); open != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, open)
+			}
+		})
+	}
+}
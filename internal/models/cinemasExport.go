@@ -0,0 +1,29 @@
+package models
+
+type GeoFormat string
+
+const (
+	GeoFormatGeoJSON GeoFormat = "geojson"
+	GeoFormatKML     GeoFormat = "kml"
+)
+
+type CinemasExportFilter struct {
+	// nil if not specified
+	CityID *int32
+	// nil if not specified
+	ChainID *int32
+}
+
+// CinemaFeature is the exported cinema with the location geometry in the export format.
+type CinemaFeature struct {
+	Geometry string `db:"geometry"`
+	Name     string `db:"name"`
+	Address  string `db:"address"`
+	Phone    string `db:"phone"`
+	Website  string `db:"website"`
+	Chain    string `db:"chain"`
+	Brand    string `db:"brand"`
+	ID       int32  `db:"id"`
+	CityID   int32  `db:"city_id"`
+	ChainID  int32  `db:"chain_id"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// CinemasFilter contains optional conditions for the cinemas listing, empty values are not applied.
+type CinemasFilter struct {
+	// Only cinemas open at the specified time.
+	OpenAt *time.Time
+	// Cinemas must have all specified amenities.
+	Amenities []string
+	// Only cinemas of the specified chains.
+	ChainsIDs []int32
+}
+
+// Match returns true if the cinema satisfies the filter.
+func (f *CinemasFilter) Match(cinema *Cinema) bool {
+	if f.OpenAt != nil && !cinema.IsOpen(*f.OpenAt) {
+		return false
+	}
+	if len(f.ChainsIDs) > 0 && !slices.Contains(f.ChainsIDs, cinema.ChainID) {
+		return false
+	}
+	for _, amenity := range f.Amenities {
+		if !cinema.HasAmenity(amenity) {
+			return false
+		}
+	}
+	return true
+}
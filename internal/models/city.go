@@ -1,6 +1,11 @@
 package models
 
 type City struct {
-	Name string `json:"name" db:"name"`
-	ID   int32  `json:"id" db:"id"`
+	Name     string `json:"name" db:"name"`
+	Currency string `json:"currency" db:"currency"`
+	TimeZone string `json:"time_zone" db:"time_zone"`
+	Locale   string `json:"locale" db:"locale"`
+	ID       int32  `json:"id" db:"id"`
+	// 0 if the city is not in any region
+	RegionID int32 `json:"region_id" db:"region_id"`
 }
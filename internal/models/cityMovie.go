@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// CityMovie is the movie with the screenings in the city.
+type CityMovie struct {
+	// Start time of the nearest screening in the city.
+	FirstScreeningTime time.Time `db:"first_screening_time"`
+	MovieID            int32     `db:"movie_id"`
+}
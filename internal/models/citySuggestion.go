@@ -0,0 +1,7 @@
+package models
+
+type CitySuggestion struct {
+	Name       string `db:"name"`
+	ID         int32  `db:"id"`
+	HasCinemas bool   `db:"has_cinemas"`
+}
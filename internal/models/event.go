@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Event groups screenings of the film festival, marathon or premiere.
+type Event struct {
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	StartDate   time.Time `json:"start_date" db:"start_date"`
+	EndDate     time.Time `json:"end_date" db:"end_date"`
+	ID          int32     `json:"id" db:"id"`
+}
@@ -0,0 +1,26 @@
+package models
+
+// GeoBounds is the bounding box of the map view.
+type GeoBounds struct {
+	MinLatitude, MinLongitude float64
+	MaxLatitude, MaxLongitude float64
+}
+
+func (b GeoBounds) IsValid() bool {
+	return b.MinLatitude >= -90 && b.MaxLatitude <= 90 &&
+		b.MinLongitude >= -180 && b.MaxLongitude <= 180 &&
+		b.MinLatitude <= b.MaxLatitude && b.MinLongitude <= b.MaxLongitude
+}
+
+// CinemasCluster is the group of the cinemas in one cell of the map grid.
+type CinemasCluster struct {
+	// The centroid of the cinemas coordinates.
+	Centroid     GeoPoint `db:"centroid"`
+	CinemasCount uint32   `db:"cinemas_count"`
+}
+
+// CinemasInBounds contains either cinemas or clusters, depends on the map zoom.
+type CinemasInBounds struct {
+	Cinemas  []Cinema
+	Clusters []CinemasCluster
+}
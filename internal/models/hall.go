@@ -3,6 +3,21 @@ package models
 type Hall struct {
 	Type string `db:"hall_type" json:"hall_type"`
 	Name string `db:"name" json:"name"`
+	// Number of places in the hall.
 	Size uint32 `db:"size" json:"size"`
-	ID   int32  `db:"id" json:"id"`
+	// Number of places suitable for wheelchair users.
+	AccessibleSize uint32                 `db:"accessible_size" json:"accessible_size"`
+	Categories     []HallCategoryCapacity `db:"-" json:"categories"`
+	// Names of the hall capabilities, for example IMAX or Dolby Atmos, includes the hall type.
+	Capabilities []string `db:"-" json:"capabilities"`
+	ID           int32    `db:"id" json:"id"`
+	CinemaID     int32    `db:"cinema_id" json:"cinema_id"`
+	// Current maintenance window, nil if the hall is available. Isn't cached.
+	Maintenance *HallMaintenanceWindow `db:"-" json:"-"`
+}
+
+// HallCategoryCapacity is the number of places of the seat category in the hall.
+type HallCategoryCapacity struct {
+	Category string `db:"category" json:"category"`
+	Capacity uint32 `db:"capacity" json:"capacity"`
 }
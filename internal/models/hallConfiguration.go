@@ -0,0 +1,8 @@
+package models
+
+type HallConfiguration struct {
+	Places      []Place
+	TotalPlaces uint32
+	// Number of free places, nil if availability is unknown.
+	FreePlaces *uint32
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// HallMaintenanceWindow is the period when the hall is offline, for example for projector servicing or cleaning.
+type HallMaintenanceWindow struct {
+	StartTime time.Time `json:"start_time" db:"start_time"`
+	EndTime   time.Time `json:"end_time" db:"end_time"`
+	Reason    string    `json:"reason" db:"reason"`
+	// IDs of the scheduled screenings in the window, that need a reschedule.
+	AffectedScreeningsIDs []int64 `json:"affected_screenings_ids" db:"-"`
+	ID                    int32   `json:"id" db:"id"`
+	HallID                int32   `json:"hall_id" db:"hall_id"`
+}
+
+// Intersects returns true if the window intersects the period from the start to the end.
+func (w *HallMaintenanceWindow) Intersects(start, end time.Time) bool {
+	return start.Before(w.EndTime) && end.After(w.StartTime)
+}
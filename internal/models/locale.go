@@ -0,0 +1,16 @@
+package models
+
+import "context"
+
+type localeKey struct{}
+
+// ContextWithLocale returns the context with the locale for the names translations.
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale from the context, empty string means the default locale.
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
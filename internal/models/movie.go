@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Movie is the movie metadata from the movie catalog.
+type Movie struct {
+	Title     string        `json:"title"`
+	AgeRating string        `json:"age_rating"`
+	Runtime   time.Duration `json:"runtime"`
+	ID        int32         `json:"id"`
+}
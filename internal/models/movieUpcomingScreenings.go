@@ -0,0 +1,8 @@
+package models
+
+// MovieUpcomingScreenings is the movie with its next screenings in the cinema.
+type MovieUpcomingScreenings struct {
+	// Ordered by the start time.
+	Screenings []Screening
+	MovieID    int32
+}
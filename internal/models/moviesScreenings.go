@@ -1,7 +1,23 @@
 package models
 
+import "time"
+
 type MoviesScreenings struct {
 	ScreeningsTypes []string `json:"screenings_types" db:"screenings_types"`
 	HallsTypes      []string `json:"halls_types" db:"halls_types"`
 	MovieID         int32    `json:"movie_id" db:"movie_id"`
+	// nil if the stats are not requested
+	Stats *MovieScreeningsStats `json:"stats,omitempty" db:"-"`
+}
+
+// MovieScreeningsStats is the summary of the movie screenings in the requested period.
+type MovieScreeningsStats struct {
+	ScreeningsCount   uint32    `json:"screenings_count"`
+	CinemasCount      uint32    `json:"cinemas_count"`
+	EarliestStartTime time.Time `json:"earliest_start_time"`
+	LatestStartTime   time.Time `json:"latest_start_time"`
+	// empty if the screenings have no price
+	MinPrice  string  `json:"min_price"`
+	MaxPrice  string  `json:"max_price"`
+	CitiesIDs []int32 `json:"cities_ids"`
 }
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// NewScreening is the screening to schedule.
+type NewScreening struct {
+	StartTime time.Time
+	// The screening must end before the cinema closing.
+	// If zero, it's calculated from the movie runtime received from the movie catalog.
+	EndTime time.Time
+	// Decimal price value, for example 100.10
+	TicketPrice     string
+	MovieID         int32
+	HallID          int32
+	ScreeningTypeID int32
+	ScreeningAttributes
+}
@@ -0,0 +1,19 @@
+package models
+
+// Country contains the defaults inherited by the country cities.
+type Country struct {
+	Name string `db:"name"`
+	// ISO 3166-1 alpha-2 code.
+	Code string `db:"code"`
+	// ISO 4217 code.
+	Currency string `db:"currency"`
+	TimeZone string `db:"time_zone"`
+	Locale   string `db:"locale"`
+	ID       int32  `db:"id"`
+}
+
+type Region struct {
+	Name      string `db:"name"`
+	ID        int32  `db:"id"`
+	CountryID int32  `db:"country_id"`
+}
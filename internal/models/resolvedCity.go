@@ -0,0 +1,8 @@
+package models
+
+type ResolvedCity struct {
+	City
+	// true if the point is inside the city boundary,
+	// false if the city is the city of the nearest cinema
+	ByBoundary bool `db:"by_boundary"`
+}
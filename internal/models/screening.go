@@ -10,4 +10,13 @@ type Screening struct {
 	HallID        int32     `json:"hall_id" db:"hall_id"`
 	MovieID       int32     `json:"movie_id" db:"movie_id"`
 	CinemaID      int32     `json:"cinema_id" db:"cinema_id"`
+	ScreeningAttributes
+	ScreeningStatusInfo
+	ScreeningSalesWindow
+	// IDs of the events that the screening belongs to.
+	EventsIDs []int32 `json:"events_ids" db:"-"`
+	// nil if the end time is not stored and the screening is not enriched.
+	EndTime *time.Time `json:"end_time" db:"end_time"`
+	// Empty if the screening is not enriched from the movie catalog.
+	MovieTitle string `json:"-" db:"-"`
 }
@@ -0,0 +1,15 @@
+package models
+
+// ScreeningAttributes describes the language and accessibility of the screening.
+type ScreeningAttributes struct {
+	// ISO 639-1 code of the spoken language.
+	SpokenLanguage string `json:"spoken_language" db:"spoken_language"`
+	// ISO 639-1 code of the subtitles language, empty if the screening is without subtitles.
+	SubtitlesLanguage string `json:"subtitles_language" db:"subtitles_language"`
+	OriginalLanguage  bool   `json:"original_language" db:"original_language"`
+	AudioDescription  bool   `json:"audio_description" db:"audio_description"`
+	ClosedCaptions    bool   `json:"closed_captions" db:"closed_captions"`
+	AgeRating         string `json:"age_rating" db:"age_rating"`
+	// Relaxed (sensory-friendly) screening.
+	Relaxed bool `json:"relaxed" db:"relaxed"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ScreeningSalesWindow is the period when tickets for the screening can be bought.
+type ScreeningSalesWindow struct {
+	SalesOpenAt  time.Time `json:"sales_open_at" db:"sales_open_at"`
+	SalesCloseAt time.Time `json:"sales_close_at" db:"sales_close_at"`
+}
+
+// IsPurchasable returns true if tickets for the screening with specified status can be bought at the specified time.
+func (w ScreeningSalesWindow) IsPurchasable(status ScreeningStatus, now time.Time) bool {
+	return status == ScreeningStatusScheduled && !now.Before(w.SalesOpenAt) && now.Before(w.SalesCloseAt)
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+type ScreeningStatus string
+
+const (
+	ScreeningStatusScheduled ScreeningStatus = "scheduled"
+	ScreeningStatusCancelled ScreeningStatus = "cancelled"
+	ScreeningStatusPostponed ScreeningStatus = "postponed"
+	// The screening moved to another hall or time, replacement screening must be specified.
+	ScreeningStatusMoved   ScreeningStatus = "moved"
+	ScreeningStatusSoldOut ScreeningStatus = "sold_out"
+)
+
+// ScreeningStatusInfo is the current lifecycle status of the screening.
+type ScreeningStatusInfo struct {
+	Status    ScreeningStatus `json:"status" db:"status"`
+	Reason    string          `json:"status_reason" db:"status_reason"`
+	ChangedAt time.Time       `json:"status_changed_at" db:"status_changed_at"`
+	// ID of the screening that replaces the moved or postponed screening, nil if not specified.
+	ReplacementScreeningID *int64 `json:"replacement_screening_id" db:"replacement_screening_id"`
+}
+
+type ScreeningStatusChange struct {
+	ScreeningID int64
+	// The status from which the transition is checked, the change is applied only if the screening still has it.
+	PreviousStatus         ScreeningStatus
+	Status                 ScreeningStatus
+	Reason                 string
+	ReplacementScreeningID *int64
+}
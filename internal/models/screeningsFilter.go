@@ -0,0 +1,26 @@
+package models
+
+// ScreeningsFilter contains optional conditions for the screenings listing, empty values are not applied.
+type ScreeningsFilter struct {
+	// Screenings halls must have all specified capabilities.
+	HallsCapabilities []string
+
+	SpokenLanguage    string
+	SubtitlesLanguage string
+	// Only screenings in the original language.
+	OriginalLanguage bool
+	// Only screenings with audio description.
+	AudioDescription bool
+	// Only screenings with closed captions.
+	ClosedCaptions bool
+	// Only relaxed (sensory-friendly) screenings.
+	Relaxed bool
+	// Screening age rating must be one of the specified.
+	AgeRatings []string
+	// If false, cancelled and moved screenings are not returned.
+	IncludeCancelled bool
+	// Only screenings with tickets on sale at the moment.
+	PurchasableOnly bool
+	// Only screenings in the cinemas of the specified chains.
+	ChainsIDs []int32
+}
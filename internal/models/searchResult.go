@@ -0,0 +1,21 @@
+package models
+
+type SearchResultType string
+
+const (
+	SearchResultTypeCity   SearchResultType = "city"
+	SearchResultTypeCinema SearchResultType = "cinema"
+)
+
+type SearchResult struct {
+	Type SearchResultType `db:"type"`
+	Name string           `db:"name"`
+	// Empty for the cities.
+	Address string `db:"address"`
+	// Name and address with the matched words wrapped into <em></em>.
+	NameHighlight    string  `db:"-"`
+	AddressHighlight string  `db:"-"`
+	Score            float32 `db:"score"`
+	ID               int32   `db:"id"`
+	CityID           int32   `db:"city_id"`
+}
@@ -0,0 +1,76 @@
+package moviecatalog
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+type Catalog interface {
+	GetMovies(ctx context.Context, ids []int32) (map[int32]models.Movie, error)
+}
+
+type cachedMovie struct {
+	movie     models.Movie
+	expiresAt time.Time
+}
+
+// CachingCatalog caches the movies of the catalog in memory, only missing or expired movies are requested.
+type CachingCatalog struct {
+	catalog Catalog
+	ttl     time.Duration
+
+	mu     sync.RWMutex
+	movies map[int32]cachedMovie
+}
+
+func NewCachingCatalog(catalog Catalog, ttl time.Duration) *CachingCatalog {
+	return &CachingCatalog{
+		catalog: catalog,
+		ttl:     ttl,
+		movies:  make(map[int32]cachedMovie),
+	}
+}
+
+func (c *CachingCatalog) GetMovies(ctx context.Context, ids []int32) (map[int32]models.Movie, error) {
+	movies := make(map[int32]models.Movie, len(ids))
+	var missing []int32
+
+	now := time.Now()
+	c.mu.RLock()
+	for _, id := range ids {
+		cached, ok := c.movies[id]
+		if ok && now.Before(cached.expiresAt) {
+			movies[id] = cached.movie
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	c.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return movies, nil
+	}
+
+	received, err := c.catalog.GetMovies(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := time.Now().Add(c.ttl)
+	for id, movie := range received {
+		c.movies[id] = cachedMovie{movie: movie, expiresAt: expiresAt}
+		movies[id] = movie
+	}
+	// expired movies are removed on write, so the cache doesn't grow with the removed movies
+	for id, cached := range c.movies {
+		if now.After(cached.expiresAt) {
+			delete(c.movies, id)
+		}
+	}
+	return movies, nil
+}
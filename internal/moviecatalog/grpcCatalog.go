@@ -0,0 +1,80 @@
+package moviecatalog
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	movies_service "github.com/Falokut/cinema_service/pkg/movies_service/v1/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	// Address of the movies service, if empty the static file is used.
+	Addr    string        `yaml:"addr" env:"MOVIE_CATALOG_ADDR"`
+	Timeout time.Duration `yaml:"timeout" env:"MOVIE_CATALOG_TIMEOUT"`
+	// TTL of the movies cached in memory, if zero the movies are not cached.
+	CacheTTL time.Duration `yaml:"cache_ttl" env:"MOVIE_CATALOG_CACHE_TTL"`
+	// Path to the json file with the movies for the offline use, used if the address is empty.
+	StaticFile string `yaml:"static_file" env:"MOVIE_CATALOG_STATIC_FILE"`
+}
+
+// GrpcCatalog receives the movies from the movies service.
+type GrpcCatalog struct {
+	conn    *grpc.ClientConn
+	client  movies_service.MoviesServiceV1Client
+	timeout time.Duration
+}
+
+func NewGrpcCatalog(cfg Config) (*GrpcCatalog, error) {
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrpcCatalog{
+		conn:    conn,
+		client:  movies_service.NewMoviesServiceV1Client(conn),
+		timeout: cfg.Timeout,
+	}, nil
+}
+
+func (c *GrpcCatalog) Close() error {
+	return c.conn.Close()
+}
+
+func (c *GrpcCatalog) GetMovies(ctx context.Context, ids []int32) (map[int32]models.Movie, error) {
+	if len(ids) == 0 {
+		return map[int32]models.Movie{}, nil
+	}
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = strconv.Itoa(int(id))
+	}
+
+	res, err := c.client.GetMoviesPreview(ctx,
+		&movies_service.GetMoviesPreviewRequest{MoviesIDs: strings.Join(strIDs, ",")})
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make(map[int32]models.Movie, len(res.Movies))
+	for _, movie := range res.Movies {
+		movies[movie.MovieID] = models.Movie{
+			ID:        movie.MovieID,
+			Title:     movie.Title,
+			AgeRating: movie.AgeRating,
+			Runtime:   time.Duration(movie.Duration) * time.Minute,
+		}
+	}
+	return movies, nil
+}
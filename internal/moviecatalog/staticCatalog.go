@@ -0,0 +1,58 @@
+package moviecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+type staticMovie struct {
+	ID    int32  `json:"id"`
+	Title string `json:"title"`
+	// runtime in minutes
+	Duration  int32  `json:"duration"`
+	AgeRating string `json:"age_rating"`
+}
+
+// StaticCatalog returns the movies loaded from the json file, used for offline use and local development.
+type StaticCatalog struct {
+	movies map[int32]models.Movie
+}
+
+// NewStaticCatalog loads the movies from the json file with the array of objects like
+// {"id": 1, "title": "Movie", "duration": 120, "age_rating": "16+"}.
+func NewStaticCatalog(path string) (*StaticCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var staticMovies []staticMovie
+	if err = json.Unmarshal(data, &staticMovies); err != nil {
+		return nil, err
+	}
+
+	movies := make(map[int32]models.Movie, len(staticMovies))
+	for _, movie := range staticMovies {
+		movies[movie.ID] = models.Movie{
+			ID:        movie.ID,
+			Title:     movie.Title,
+			AgeRating: movie.AgeRating,
+			Runtime:   time.Duration(movie.Duration) * time.Minute,
+		}
+	}
+	return &StaticCatalog{movies: movies}, nil
+}
+
+func (c *StaticCatalog) GetMovies(_ context.Context, ids []int32) (map[int32]models.Movie, error) {
+	movies := make(map[int32]models.Movie, len(ids))
+	for _, id := range ids {
+		if movie, ok := c.movies[id]; ok {
+			movies[id] = movie
+		}
+	}
+	return movies, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,215 +28,1151 @@ func NewCinemaRepository(logger *logrus.Logger, db *sqlx.DB) *CinemaRepository {
 }
 
 const (
-	cinemasTableName             = "cinemas"
-	citiesTableName              = "cities"
-	screeningTypeTableName       = "screenings_types"
-	hallsTypesTableName          = "halls_types"
-	hallsTableName               = "halls"
-	screeningsTableName          = "screenings"
-	hallsConfigurationsTableName = "halls_configurations"
+	cinemasTableName                   = "cinemas"
+	citiesTableName                    = "cities"
+	screeningTypeTableName             = "screenings_types"
+	hallsTypesTableName                = "halls_types"
+	hallsTableName                     = "halls"
+	screeningsTableName                = "screenings"
+	hallsConfigurationsTableName       = "halls_configurations"
+	placesCategoriesTableName          = "places_categories"
+	hallsCapacitiesTableName           = "halls_capacities"
+	screeningsStatusesHistoryTableName = "screenings_statuses_history"
+	eventsTableName                    = "events"
+	eventsScreeningsTableName          = "events_screenings"
+	cinemasOpeningHoursTableName       = "cinemas_opening_hours"
+	cinemasClosuresTableName           = "cinemas_closures"
+	hallsMaintenanceWindowsTableName   = "halls_maintenance_windows"
+	amenitiesTableName                 = "amenities"
+	cinemasAmenitiesTableName          = "cinemas_amenities"
+	cinemasPhotosTableName             = "cinemas_photos"
+	chainsTableName                    = "chains"
+	brandsTableName                    = "brands"
+
+	screeningAttributesColumns = "spoken_language, subtitles_language, original_language, audio_description, " +
+		"closed_captions, age_rating, relaxed"
+	screeningStatusColumns      = "status, status_reason, status_changed_at, replacement_screening_id"
+	screeningSalesWindowColumns = "sales_open_at, sales_close_at"
+	// columns of the models.ScreeningAttributes, models.ScreeningStatusInfo, models.ScreeningSalesWindow and the end time
+	screeningDetailsColumns = screeningAttributesColumns + ", " + screeningStatusColumns + ", " + screeningSalesWindowColumns +
+		", end_time"
 )
 
 func (r *CinemaRepository) GetCinemasInCity(ctx context.Context, id int32) (cinemas []models.Cinema, err error) {
 	defer r.handleError(ctx, &err, "GetCinemasInCity")
 
 	query := fmt.Sprintf(`
-	SELECT id, name, address, ST_AsText(coordinates) AS coordinates
+	SELECT %s
 	FROM %s
 	WHERE city_id=$1
 	ORDER BY id`,
-		cinemasTableName)
+		cinemaColumns("$2"), cinemasTableName)
+
+	err = r.db.SelectContext(ctx, &cinemas, query, id, models.LocaleFromContext(ctx))
+	if err != nil || len(cinemas) == 0 {
+		return
+	}
+	err = r.fillCinemasDetails(ctx, cinemas)
+	return
+}
+
+// geographyColumn returns the geography with the longitude first, the geography functions expect the longitude
+// as the X coordinate, but the coordinates are stored as POINT(latitude longitude).
+func geographyColumn(column string) string {
+	return fmt.Sprintf("ST_FlipCoordinates(%s::geometry)::geography", column)
+}
+
+// coordinates are stored as POINT(latitude longitude)
+const boundsEnvelope = "ST_MakeEnvelope($1, $2, $3, $4, 4326)"
+
+func (r *CinemaRepository) GetCinemasInBounds(ctx context.Context,
+	bounds models.GeoBounds, filter models.CinemasFilter) (cinemas []models.Cinema, err error) {
+	defer r.handleError(ctx, &err, "GetCinemasInBounds")
+
+	filterCondition, filterArgs := cinemasFilterCondition(filter, 6)
+	query := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE coordinates::geometry && %s%s
+	ORDER BY id`,
+		cinemaColumns("$5"), cinemasTableName, boundsEnvelope, filterCondition)
+
+	err = r.db.SelectContext(ctx, &cinemas, query, append([]any{
+		bounds.MinLatitude, bounds.MinLongitude, bounds.MaxLatitude, bounds.MaxLongitude,
+		models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil || len(cinemas) == 0 {
+		return
+	}
+	err = r.fillCinemasDetails(ctx, cinemas)
+	return
+}
+
+func (r *CinemaRepository) GetCinemasClustersInBounds(ctx context.Context,
+	bounds models.GeoBounds, gridSize float64, filter models.CinemasFilter) (clusters []models.CinemasCluster, err error) {
+	defer r.handleError(ctx, &err, "GetCinemasClustersInBounds")
+
+	filterCondition, filterArgs := cinemasFilterCondition(filter, 6)
+	query := fmt.Sprintf(`
+	SELECT COUNT(*) AS cinemas_count, ST_AsText(ST_Centroid(ST_Collect(coordinates::geometry))) AS centroid
+	FROM %s
+	WHERE coordinates::geometry && %s%s
+	GROUP BY ST_SnapToGrid(coordinates::geometry, $5)
+	ORDER BY cinemas_count DESC`,
+		cinemasTableName, boundsEnvelope, filterCondition)
+
+	err = r.db.SelectContext(ctx, &clusters, query, append([]any{
+		bounds.MinLatitude, bounds.MinLongitude, bounds.MaxLatitude, bounds.MaxLongitude, gridSize}, filterArgs...)...)
+	return
+}
+
+// ExportCinemas streams the cinemas rows into the fn, the rows are not loaded into memory at once.
+func (r *CinemaRepository) ExportCinemas(ctx context.Context, filter models.CinemasExportFilter,
+	format models.GeoFormat, fn func(models.CinemaFeature) error) (err error) {
+	defer r.handleError(ctx, &err, "ExportCinemas")
+
+	// coordinates are stored as POINT(latitude longitude), the formats require longitude first
+	geometry := "ST_AsGeoJSON(ST_FlipCoordinates(coordinates::geometry))"
+	if format == models.GeoFormatKML {
+		geometry = "ST_AsKML(ST_FlipCoordinates(coordinates::geometry))"
+	}
+
+	query := fmt.Sprintf(`
+	SELECT %[1]s AS geometry, id, %[2]s AS name, address, COALESCE(city_id, 0) AS city_id, phone, website,
+	COALESCE(chain_id, 0) AS chain_id,
+	COALESCE((SELECT name FROM %[4]s WHERE %[4]s.id=chain_id), '') AS chain,
+	COALESCE((SELECT name FROM %[5]s WHERE %[5]s.id=brand_id), '') AS brand
+	FROM %[3]s
+	WHERE ($1::INT IS NULL OR city_id=$1) AND ($2::INT IS NULL OR chain_id=$2)
+	ORDER BY id`,
+		geometry, localizedName(cinemasTableName, "id", cinemasTranslationsTableName, "$3"),
+		cinemasTableName, chainsTableName, brandsTableName)
+
+	rows, err := r.db.QueryxContext(ctx, query, filter.CityID, filter.ChainID, models.LocaleFromContext(ctx))
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cinema models.CinemaFeature
+		if err = rows.StructScan(&cinema); err != nil {
+			return
+		}
+		if err = fn(cinema); err != nil {
+			return
+		}
+	}
+	return rows.Err()
+}
+
+// cinemaColumns returns the columns of the models.Cinema, name is translated into the locale from the localeArg placeholder.
+func cinemaColumns(localeArg string) string {
+	return fmt.Sprintf(`id, %[3]s AS name, address, ST_AsText(coordinates) AS coordinates,
+	COALESCE(%[5]s.time_zone, (SELECT time_zone FROM %[4]s WHERE %[4]s.id=%[5]s.city_id), 'UTC') AS time_zone,
+	phone, website,
+	COALESCE(chain_id, 0) AS chain_id,
+	COALESCE((SELECT name FROM %[1]s WHERE %[1]s.id=chain_id), '') AS chain,
+	COALESCE((SELECT name FROM %[2]s WHERE %[2]s.id=brand_id), '') AS brand`, chainsTableName, brandsTableName,
+		localizedName(cinemasTableName, "id", cinemasTranslationsTableName, localeArg),
+		citiesSettingsViewName, cinemasTableName)
+}
+
+func (r *CinemaRepository) ListChains(ctx context.Context, cityID int32) (chains []models.Chain, err error) {
+	defer r.handleError(ctx, &err, "ListChains")
+
+	query := fmt.Sprintf(`
+	SELECT id, name
+	FROM %[1]s
+	WHERE id IN (SELECT chain_id FROM %[2]s WHERE city_id=$1)
+	ORDER BY name`, chainsTableName, cinemasTableName)
+
+	err = r.db.SelectContext(ctx, &chains, query, cityID)
+	if err != nil || len(chains) == 0 {
+		return
+	}
+
+	ids := make([]int32, len(chains))
+	for i := range chains {
+		ids[i] = chains[i].ID
+	}
+
+	// only brands of the city cinemas
+	query = fmt.Sprintf(`
+	SELECT DISTINCT %[1]s.chain_id, %[1]s.name AS value
+	FROM %[1]s
+	JOIN %[2]s ON brand_id=%[1]s.id
+	WHERE city_id=$1 AND %[1]s.chain_id=ANY($2)
+	ORDER BY %[1]s.chain_id, value`, brandsTableName, cinemasTableName)
+
+	var brands []struct {
+		ChainID int32  `db:"chain_id"`
+		Value   string `db:"value"`
+	}
+	if err = r.db.SelectContext(ctx, &brands, query, cityID, ids); err != nil {
+		return
+	}
 
-	err = r.db.SelectContext(ctx, &cinemas, query, id)
+	chainsBrands := make(map[int32][]string, len(chains))
+	for _, brand := range brands {
+		chainsBrands[brand.ChainID] = append(chainsBrands[brand.ChainID], brand.Value)
+	}
+	for i := range chains {
+		chains[i].Brands = chainsBrands[chains[i].ID]
+	}
 	return
 }
 
+// minimal word similarity of the search query and the cinema or city
+const searchSimilarityThreshold = "0.3"
+
+func (r *CinemaRepository) Search(ctx context.Context, query string, cityID *int32,
+	limit int) (results []models.SearchResult, err error) {
+	defer r.handleError(ctx, &err, "Search")
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return
+	}
+	defer tx.Rollback()
+
+	// threshold for the <% operator, the operator allows to use trigram indexes
+	_, err = tx.ExecContext(ctx, "SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)",
+		searchSimilarityThreshold)
+	if err != nil {
+		return
+	}
+
+	// names are matched in the default locale and in the requested locale, the best match is used for the score
+	sqlQuery := fmt.Sprintf(`
+	SELECT * FROM (
+		SELECT 'city' AS type, id, %[6]s AS name, '' AS address, id AS city_id,
+		GREATEST(word_similarity(transliterate($1), transliterate(name)), COALESCE((
+			SELECT word_similarity(transliterate($1), transliterate(%[4]s.name))
+			FROM %[4]s WHERE %[4]s.id=%[1]s.id AND %[4]s.locale=$4), 0)) AS score
+		FROM %[1]s
+		WHERE (transliterate($1) <%% transliterate(name) OR id IN (
+			SELECT id FROM %[4]s WHERE locale=$4 AND transliterate($1) <%% transliterate(name)))
+		AND ($2::INT IS NULL OR id=$2) AND %[3]s
+		UNION ALL
+		SELECT 'cinema' AS type, id, %[7]s AS name, address, COALESCE(city_id, 0) AS city_id,
+		GREATEST(word_similarity(transliterate($1), transliterate(name || ' ' || address)), COALESCE((
+			SELECT word_similarity(transliterate($1), transliterate(%[5]s.name))
+			FROM %[5]s WHERE %[5]s.id=%[2]s.id AND %[5]s.locale=$4), 0)) AS score
+		FROM %[2]s
+		WHERE (transliterate($1) <%% transliterate(name || ' ' || address) OR id IN (
+			SELECT id FROM %[5]s WHERE locale=$4 AND transliterate($1) <%% transliterate(name)))
+		AND ($2::INT IS NULL OR city_id=$2)
+	) AS results
+	ORDER BY score DESC, type, id
+	LIMIT $3`, citiesTableName, cinemasTableName, cityHasCinemasCondition,
+		citiesTranslationsTableName, cinemasTranslationsTableName,
+		localizedName(citiesTableName, "id", citiesTranslationsTableName, "$4"),
+		localizedName(cinemasTableName, "id", cinemasTranslationsTableName, "$4"))
+
+	err = tx.SelectContext(ctx, &results, sqlQuery, query, cityID, limit, models.LocaleFromContext(ctx))
+	return
+}
+
+// fillCinemasDetails fills the cinemas schedules, amenities and photos.
+func (r *CinemaRepository) fillCinemasDetails(ctx context.Context, cinemas []models.Cinema) error {
+	if err := r.fillCinemasSchedules(ctx, cinemas); err != nil {
+		return err
+	}
+	return r.fillCinemasAmenitiesAndPhotos(ctx, cinemas)
+}
+
+type cinemaValue struct {
+	CinemaID int32  `db:"cinema_id"`
+	Value    string `db:"value"`
+}
+
+func (r *CinemaRepository) fillCinemasAmenitiesAndPhotos(ctx context.Context, cinemas []models.Cinema) error {
+	ids := make([]int32, len(cinemas))
+	for i := range cinemas {
+		ids[i] = cinemas[i].ID
+	}
+
+	query := fmt.Sprintf(`
+	SELECT cinema_id, %[1]s.name AS value
+	FROM %[2]s
+	JOIN %[1]s ON amenity_id=%[1]s.id
+	WHERE cinema_id=ANY($1)
+	ORDER BY cinema_id, %[1]s.name`, amenitiesTableName, cinemasAmenitiesTableName)
+
+	var amenities []cinemaValue
+	if err := r.db.SelectContext(ctx, &amenities, query, ids); err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(`
+	SELECT cinema_id, url AS value
+	FROM %s
+	WHERE cinema_id=ANY($1)
+	ORDER BY cinema_id, id`, cinemasPhotosTableName)
+
+	var photos []cinemaValue
+	if err := r.db.SelectContext(ctx, &photos, query, ids); err != nil {
+		return err
+	}
+
+	cinemasAmenities := make(map[int32][]string, len(cinemas))
+	for _, a := range amenities {
+		cinemasAmenities[a.CinemaID] = append(cinemasAmenities[a.CinemaID], a.Value)
+	}
+	cinemasPhotos := make(map[int32][]string, len(cinemas))
+	for _, p := range photos {
+		cinemasPhotos[p.CinemaID] = append(cinemasPhotos[p.CinemaID], p.Value)
+	}
+	for i := range cinemas {
+		cinemas[i].Amenities = cinemasAmenities[cinemas[i].ID]
+		cinemas[i].PhotosURLs = cinemasPhotos[cinemas[i].ID]
+	}
+	return nil
+}
+
+type cinemaOpeningHours struct {
+	CinemaID int32 `db:"cinema_id"`
+	models.OpeningHours
+}
+
+type cinemaClosure struct {
+	CinemaID int32 `db:"cinema_id"`
+	models.CinemaClosure
+}
+
+// fillCinemasSchedules fills the cinemas opening hours and not finished closures.
+func (r *CinemaRepository) fillCinemasSchedules(ctx context.Context, cinemas []models.Cinema) error {
+	ids := make([]int32, len(cinemas))
+	for i := range cinemas {
+		ids[i] = cinemas[i].ID
+	}
+
+	query := fmt.Sprintf(`
+	SELECT cinema_id, weekday,
+	(EXTRACT(EPOCH FROM opens_at)/60)::INT AS opens_at, (EXTRACT(EPOCH FROM closes_at)/60)::INT AS closes_at
+	FROM %s
+	WHERE cinema_id=ANY($1)
+	ORDER BY cinema_id, weekday, opens_at`, cinemasOpeningHoursTableName)
+
+	var hours []cinemaOpeningHours
+	if err := r.db.SelectContext(ctx, &hours, query, ids); err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(`
+	SELECT cinema_id, start_time, end_time, reason
+	FROM %s
+	WHERE cinema_id=ANY($1) AND end_time>NOW()
+	ORDER BY cinema_id, start_time`, cinemasClosuresTableName)
+
+	var closures []cinemaClosure
+	if err := r.db.SelectContext(ctx, &closures, query, ids); err != nil {
+		return err
+	}
+
+	cinemasHours := make(map[int32][]models.OpeningHours, len(cinemas))
+	for _, h := range hours {
+		cinemasHours[h.CinemaID] = append(cinemasHours[h.CinemaID], h.OpeningHours)
+	}
+	cinemasClosures := make(map[int32][]models.CinemaClosure, len(cinemas))
+	for _, c := range closures {
+		cinemasClosures[c.CinemaID] = append(cinemasClosures[c.CinemaID], c.CinemaClosure)
+	}
+	for i := range cinemas {
+		cinemas[i].OpeningHours = cinemasHours[cinemas[i].ID]
+		cinemas[i].Closures = cinemasClosures[cinemas[i].ID]
+	}
+	return nil
+}
+
 func (r *CinemaRepository) GetCinemasCities(ctx context.Context) (cities []models.City, err error) {
 	defer r.handleError(ctx, &err, "GetCinemasCities")
 
 	// query to select all cities where there are cinemas.
-	// In some cases, there may be a database record for a city that does not have any cinemas.
-	query := fmt.Sprintf("SELECT id,name FROM %[1]s WHERE id=ANY(SELECT DISTINCT city_id FROM %[2]s) ORDER BY id",
-		citiesTableName, cinemasTableName)
+	query := fmt.Sprintf("SELECT %[2]s FROM %[1]s %[3]s WHERE %[4]s ORDER BY %[1]s.id",
+		citiesTableName, cityColumns("$1"), citiesSettingsJoin, cityHasCinemasCondition)
+
+	err = r.db.SelectContext(ctx, &cities, query, models.LocaleFromContext(ctx))
+	return
+}
+
+func (r *CinemaRepository) ResolveCity(ctx context.Context, point models.GeoPoint) (city models.ResolvedCity, err error) {
+	defer r.handleError(ctx, &err, "ResolveCity")
+
+	// the smallest city boundary containing the point, or the city of the nearest cinema if there is no such boundary,
+	// the point is flipped, because the boundaries are stored longitude first
+	query := fmt.Sprintf(`
+	WITH by_boundary AS (
+		SELECT id FROM %[1]s
+		WHERE boundary IS NOT NULL AND ST_Covers(boundary, %[6]s)
+		ORDER BY ST_Area(boundary)
+		LIMIT 1
+	), by_nearest_cinema AS (
+		SELECT city_id AS id FROM %[2]s
+		WHERE city_id IS NOT NULL
+		ORDER BY %[5]s <-> %[6]s
+		LIMIT 1
+	)
+	SELECT %[3]s, %[1]s.id IN (SELECT id FROM by_boundary) AS by_boundary
+	FROM %[1]s %[4]s
+	WHERE %[1]s.id=COALESCE((SELECT id FROM by_boundary), (SELECT id FROM by_nearest_cinema))`,
+		citiesTableName, cinemasTableName, cityColumns("$2"), citiesSettingsJoin, geographyColumn("coordinates"),
+		geographyColumn("ST_GeomFromText($1, 4326)"))
 
-	err = r.db.SelectContext(ctx, &cities, query)
+	err = r.db.GetContext(ctx, &city, query, point, models.LocaleFromContext(ctx))
 	return
 }
 
+// In some cases, there may be a database record for a city that does not have any cinemas.
+var cityHasCinemasCondition = fmt.Sprintf("%s.id=ANY(SELECT DISTINCT city_id FROM %s)", citiesTableName, cinemasTableName)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *CinemaRepository) AutocompleteCities(ctx context.Context,
+	prefix string, limit int) (cities []models.CitySuggestion, err error) {
+	defer r.handleError(ctx, &err, "AutocompleteCities")
+
+	// the name or any of the name translations must start with the prefix,
+	// both are transliterated, so the prefix may be in cyrillic or latin
+	query := fmt.Sprintf(`
+		SELECT id, %[3]s AS name, %[4]s AS has_cinemas
+		FROM %[1]s
+		WHERE transliterate(name) LIKE transliterate($1) || '%%'
+		OR id IN (SELECT id FROM %[2]s WHERE transliterate(name) LIKE transliterate($1) || '%%')
+		ORDER BY has_cinemas DESC, name, id
+		LIMIT $3`,
+		citiesTableName, citiesTranslationsTableName,
+		localizedName(citiesTableName, "id", citiesTranslationsTableName, "$2"), cityHasCinemasCondition)
+
+	err = r.db.SelectContext(ctx, &cities, query, likeEscaper.Replace(prefix), models.LocaleFromContext(ctx), limit)
+	return
+}
+
+// aggregates the names of the screenings halls capabilities, hall type is included
+// hallsTypesAggregation returns the aggregation of the halls capabilities names, the halls types names are translated.
+func hallsTypesAggregation(localeArg string) string {
+	return fmt.Sprintf("COALESCE(ARRAY_AGG(DISTINCT %[1]s) FILTER (WHERE %[2]s.name IS NOT NULL), '{}')",
+		hallCapabilityName(localeArg), hallsCapabilitiesNamesViewName)
+}
+
 type previewScreening struct {
 	MovieID         int32  `db:"movie_id"`
 	ScreeningsTypes string `db:"screenings_types"`
 	HallsTypes      string `db:"halls_types"`
+
+	// selected only with the moviesScreeningsStatsAggregation
+	ScreeningsCount   uint32    `db:"screenings_count"`
+	CinemasCount      uint32    `db:"cinemas_count"`
+	EarliestStartTime time.Time `db:"earliest_start_time"`
+	LatestStartTime   time.Time `db:"latest_start_time"`
+	MinPrice          string    `db:"min_price"`
+	MaxPrice          string    `db:"max_price"`
+	CitiesIDs         string    `db:"cities_ids"`
+}
+
+// aggregates the movie screenings stats, the query must join halls and cinemas
+var moviesScreeningsStatsAggregation = fmt.Sprintf(`,
+	COUNT(DISTINCT %[1]s.id) AS screenings_count,
+	COUNT(DISTINCT %[2]s.cinema_id) AS cinemas_count,
+	MIN(%[1]s.start_time) AS earliest_start_time,
+	MAX(%[1]s.start_time) AS latest_start_time,
+	COALESCE(MIN(%[1]s.ticket_price)::TEXT, '') AS min_price,
+	COALESCE(MAX(%[1]s.ticket_price)::TEXT, '') AS max_price,
+	COALESCE(ARRAY_AGG(DISTINCT %[3]s.city_id) FILTER (WHERE %[3]s.city_id IS NOT NULL), '{}') AS cities_ids`,
+	screeningsTableName, hallsTableName, cinemasTableName)
+
+func moviesScreeningsFromPreviews(previews []previewScreening, includeStats bool) []models.MoviesScreenings {
+	screenings := make([]models.MoviesScreenings, len(previews))
+	for i, screening := range previews {
+		screenings[i] = models.MoviesScreenings{
+			MovieID:         screening.MovieID,
+			HallsTypes:      convertSQLArray(screening.HallsTypes),
+			ScreeningsTypes: convertSQLArray(screening.ScreeningsTypes),
+		}
+		if !includeStats {
+			continue
+		}
+
+		screenings[i].Stats = &models.MovieScreeningsStats{
+			ScreeningsCount:   screening.ScreeningsCount,
+			CinemasCount:      screening.CinemasCount,
+			EarliestStartTime: screening.EarliestStartTime,
+			LatestStartTime:   screening.LatestStartTime,
+			MinPrice:          screening.MinPrice,
+			MaxPrice:          screening.MaxPrice,
+			CitiesIDs:         convertSQLInt32Array(screening.CitiesIDs),
+		}
+	}
+	return screenings
 }
 
 func (r *CinemaRepository) GetMoviesScreenings(ctx context.Context,
-	cinemaID int32, startPeriod, endPeriod time.Time) (screenings []models.MoviesScreenings, err error) {
+	cinemaID int32, startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter) (screenings []models.MoviesScreenings, err error) {
 	defer r.handleError(ctx, &err, "GetMoviesScreenings")
 
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 5)
 	query := fmt.Sprintf(`
 		SELECT movie_id,
-		ARRAY_AGG(DISTINCT %[1]s.name) AS screenings_types,
-		ARRAY_AGG(DISTINCT %[2]s.name) AS halls_types 
+		ARRAY_AGG(DISTINCT %[7]s) AS screenings_types,
+		%[2]s AS halls_types 
 		FROM %[3]s
 		JOIN %[1]s ON screening_type_id = %[1]s.id 
-		JOIN %[4]s ON hall_id=%[4]s.id JOIN %[2]s ON hall_type_id = %[2]s.type_id 
-		WHERE cinema_id=$1 AND start_time>=$2 AND start_time<=$3 
+		JOIN %[4]s ON %[3]s.hall_id=%[4]s.id
+		LEFT JOIN %[5]s ON %[5]s.hall_id=%[3]s.hall_id
+		WHERE cinema_id=$1 AND start_time>=$2 AND start_time<=$3%[6]s
 		GROUP BY movie_id`,
-		screeningTypeTableName, hallsTypesTableName, screeningsTableName, hallsTableName)
+		screeningTypeTableName, hallsTypesAggregation("$4"), screeningsTableName, hallsTableName,
+		hallsCapabilitiesNamesViewName, filterCondition, screeningTypeName("$4"))
 
 	var previews []previewScreening
-	err = r.db.SelectContext(ctx, &previews, query, cinemaID, startPeriod, endPeriod)
+	err = r.db.SelectContext(ctx, &previews, query,
+		append([]any{cinemaID, startPeriod, endPeriod, models.LocaleFromContext(ctx)}, filterArgs...)...)
 	if err != nil {
 		return
 	}
 
-	screenings = make([]models.MoviesScreenings, len(previews))
-	for i, screening := range previews {
-		screenings[i] = models.MoviesScreenings{
-			MovieID:         screening.MovieID,
-			HallsTypes:      convertSQLArray(screening.HallsTypes),
-			ScreeningsTypes: convertSQLArray(screening.ScreeningsTypes),
-		}
-	}
-
+	screenings = moviesScreeningsFromPreviews(previews, false)
 	return
 }
 
 func (r *CinemaRepository) GetAllMoviesScreenings(ctx context.Context,
-	startPeriod, endPeriod time.Time) (screenings []models.MoviesScreenings, err error) {
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter,
+	includeStats bool) (screenings []models.MoviesScreenings, err error) {
 	defer r.handleError(ctx, &err, "GetMoviesScreeningsInCities")
 
+	var statsColumns, statsJoins string
+	if includeStats {
+		statsColumns = moviesScreeningsStatsAggregation
+		statsJoins = fmt.Sprintf(`
+		LEFT JOIN %[2]s ON %[1]s.hall_id=%[2]s.id
+		LEFT JOIN %[3]s ON %[2]s.cinema_id=%[3]s.id`, screeningsTableName, hallsTableName, cinemasTableName)
+	}
+
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 4)
 	query := fmt.Sprintf(`
 		SELECT movie_id, 
-		ARRAY_AGG(DISTINCT %[1]s.name) AS screenings_types,
-		ARRAY_AGG(DISTINCT %[2]s.name) AS halls_types 
+		ARRAY_AGG(DISTINCT %[6]s) AS screenings_types,
+		%[2]s AS halls_types%[7]s
 		FROM %[3]s 
 		JOIN %[1]s ON screening_type_id=%[1]s.id 
-		JOIN %[4]s ON hall_id=%[4]s.id 
-		JOIN %[2]s ON hall_type_id=%[2]s.type_id 
-		WHERE start_time>=$1 AND start_time<=$2 
+		LEFT JOIN %[4]s ON %[4]s.hall_id=%[3]s.hall_id%[8]s
+		WHERE start_time>=$1 AND start_time<=$2%[5]s
 		GROUP BY movie_id`,
-		screeningTypeTableName, hallsTypesTableName, screeningsTableName, hallsTableName)
+		screeningTypeTableName, hallsTypesAggregation("$3"), screeningsTableName,
+		hallsCapabilitiesNamesViewName, filterCondition, screeningTypeName("$3"), statsColumns, statsJoins)
 
 	var previews []previewScreening
-	err = r.db.SelectContext(ctx, &previews, query, startPeriod, endPeriod)
+	err = r.db.SelectContext(ctx, &previews, query,
+		append([]any{startPeriod, endPeriod, models.LocaleFromContext(ctx)}, filterArgs...)...)
 	if err != nil {
 		return
 	}
 
-	screenings = make([]models.MoviesScreenings, len(previews))
-	for i, screening := range previews {
-		screenings[i] = models.MoviesScreenings{
-			MovieID:         screening.MovieID,
-			HallsTypes:      convertSQLArray(screening.HallsTypes),
-			ScreeningsTypes: convertSQLArray(screening.ScreeningsTypes),
-		}
-	}
-
+	screenings = moviesScreeningsFromPreviews(previews, includeStats)
 	return
 }
 
 func (r *CinemaRepository) GetMoviesScreeningsInCities(ctx context.Context,
-	citiesIDs []int32, startPeriod, endPeriod time.Time) (screenings []models.MoviesScreenings, err error) {
+	citiesIDs []int32, startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter, includeStats bool) (screenings []models.MoviesScreenings, err error) {
 	defer r.handleError(ctx, &err, "GetMoviesScreeningsInCities")
 
+	var statsColumns, statsJoins string
+	if includeStats {
+		statsColumns = moviesScreeningsStatsAggregation
+		statsJoins = fmt.Sprintf(`
+		JOIN %[2]s ON %[1]s.cinema_id=%[2]s.id`, hallsTableName, cinemasTableName)
+	}
+
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 5)
 	query := fmt.Sprintf(`
 		SELECT movie_id,
-		ARRAY_AGG(DISTINCT %[1]s.name) AS screenings_types,
-		ARRAY_AGG(DISTINCT %[2]s.name) AS halls_types 
+		ARRAY_AGG(DISTINCT %[8]s) AS screenings_types,
+		%[2]s AS halls_types%[9]s
 		FROM %[3]s 
 		JOIN %[1]s ON screening_type_id=%[1]s.id 
-		JOIN %[4]s ON hall_id=%[4]s.id 
-		JOIN %[2]s ON hall_type_id=%[2]s.type_id 
-		WHERE cinema_id=ANY(SELECT id FROM %[5]s WHERE city_id=ANY($1)) AND start_time>=$2 AND start_time<=$3 
+		JOIN %[4]s ON %[3]s.hall_id=%[4]s.id 
+		LEFT JOIN %[6]s ON %[6]s.hall_id=%[3]s.hall_id%[10]s
+		WHERE cinema_id=ANY(SELECT id FROM %[5]s WHERE city_id=ANY($1)) AND start_time>=$2 AND start_time<=$3%[7]s
 		GROUP BY movie_id`,
-		screeningTypeTableName, hallsTypesTableName, screeningsTableName, hallsTableName, cinemasTableName)
+		screeningTypeTableName, hallsTypesAggregation("$4"), screeningsTableName, hallsTableName, cinemasTableName,
+		hallsCapabilitiesNamesViewName, filterCondition, screeningTypeName("$4"), statsColumns, statsJoins)
 
 	var previews []previewScreening
-	err = r.db.SelectContext(ctx, &previews, query, citiesIDs, startPeriod, endPeriod)
+	err = r.db.SelectContext(ctx, &previews, query,
+		append([]any{citiesIDs, startPeriod, endPeriod, models.LocaleFromContext(ctx)}, filterArgs...)...)
 	if err != nil {
 		return
 	}
 
-	screenings = make([]models.MoviesScreenings, len(previews))
-	for i, screening := range previews {
-		screenings[i] = models.MoviesScreenings{
-			MovieID:         screening.MovieID,
-			HallsTypes:      convertSQLArray(screening.HallsTypes),
-			ScreeningsTypes: convertSQLArray(screening.ScreeningsTypes),
-		}
-	}
-
+	screenings = moviesScreeningsFromPreviews(previews, includeStats)
 	return
 }
 
 func (r *CinemaRepository) GetCityScreenings(ctx context.Context,
-	cityID, movieID int32, startPeriod, endPeriod time.Time) (screenings []models.CityScreening, err error) {
+	cityID, movieID int32, startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter) (screenings []models.CityScreening, err error) {
 	defer r.handleError(ctx, &err, "GetCityScreenings")
 
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 6)
 	query := fmt.Sprintf(`
-			SELECT %[1]s.id, %[2]s.name AS screening_type, hall_id, ticket_price,start_time, cinema_id 
+			SELECT %[1]s.id, %[7]s AS screening_type, hall_id, ticket_price,start_time, cinema_id, %[6]s
 			FROM %[1]s JOIN %[2]s ON screening_type_id=%[2]s.id 
 			JOIN %[3]s ON hall_id = %[3]s.id 
 			JOIN %[4]s ON cinema_id = %[4]s.id 
-			WHERE city_id=$1 AND movie_id=$2 AND start_time>=$3 AND start_time<=$4 
+			WHERE city_id=$1 AND movie_id=$2 AND start_time>=$3 AND start_time<=$4%[5]s
 			ORDER BY start_time;`,
-		screeningsTableName, screeningTypeTableName, hallsTableName, cinemasTableName)
+		screeningsTableName, screeningTypeTableName, hallsTableName, cinemasTableName, filterCondition,
+		screeningDetailsColumns, screeningTypeName("$5"))
 
-	err = r.db.SelectContext(ctx, &screenings, query, cityID, movieID, startPeriod, endPeriod)
+	err = r.db.SelectContext(ctx, &screenings, query,
+		append([]any{cityID, movieID, startPeriod, endPeriod, models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil || len(screenings) == 0 {
+		return
+	}
+
+	ids := make([]int64, len(screenings))
+	for i := range screenings {
+		ids[i] = screenings[i].ScreeningID
+	}
+	screeningsEvents, err := r.getScreeningsEvents(ctx, ids)
+	if err != nil {
+		return
+	}
+	for i := range screenings {
+		screenings[i].EventsIDs = screeningsEvents[screenings[i].ScreeningID]
+	}
+	return
+}
+
+func (r *CinemaRepository) GetNowShowing(ctx context.Context,
+	cityID int32, horizonDays uint32, filter models.ScreeningsFilter) (movies []models.CityMovie, err error) {
+	defer r.handleError(ctx, &err, "GetNowShowing")
+
+	movies, err = r.getCityMovies(ctx, cityID, horizonDays, filter, "<=")
+	return
+}
+
+func (r *CinemaRepository) GetComingSoon(ctx context.Context,
+	cityID int32, horizonDays uint32, filter models.ScreeningsFilter) (movies []models.CityMovie, err error) {
+	defer r.handleError(ctx, &err, "GetComingSoon")
+
+	movies, err = r.getCityMovies(ctx, cityID, horizonDays, filter, ">")
+	return
+}
+
+// getCityMovies returns the movies with the upcoming screenings in the city,
+// which first screening time compared with the horizon by the comparison operator.
+func (r *CinemaRepository) getCityMovies(ctx context.Context,
+	cityID int32, horizonDays uint32, filter models.ScreeningsFilter, comparison string) (movies []models.CityMovie, err error) {
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 3)
+	query := fmt.Sprintf(`
+		SELECT movie_id, MIN(start_time) AS first_screening_time
+		FROM %[1]s
+		JOIN %[2]s ON hall_id = %[2]s.id
+		JOIN %[3]s ON cinema_id = %[3]s.id
+		WHERE city_id=$1 AND start_time>=NOW()%[4]s
+		GROUP BY movie_id
+		HAVING MIN(start_time) %[5]s NOW() + make_interval(days => $2)
+		ORDER BY first_screening_time, movie_id`,
+		screeningsTableName, hallsTableName, cinemasTableName, filterCondition, comparison)
+
+	err = r.db.SelectContext(ctx, &movies, query, append([]any{cityID, int32(horizonDays)}, filterArgs...)...)
+	return
+}
+
+func (r *CinemaRepository) GetAlternativeScreenings(ctx context.Context, screening models.Screening,
+	timeWindow time.Duration, limit int, filter models.ScreeningsFilter) (alternatives []models.AlternativeScreening, err error) {
+	defer r.handleError(ctx, &err, "GetAlternativeScreenings")
+
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 9)
+	// screenings of the same movie in the same city, that still can be purchased,
+	// the same cinema goes first, then the same screening type, then the nearest cinemas and the nearest start time
+	query := fmt.Sprintf(`
+		SELECT %[1]s.id, %[6]s AS screening_type, hall_id, ticket_price, start_time, cinema_id, %[5]s,
+		ST_Distance(%[8]s, (SELECT %[8]s FROM %[4]s WHERE id=$2)) AS distance,
+		COALESCE(screening_type_id=(SELECT screening_type_id FROM %[1]s WHERE id=$3), FALSE) AS same_screening_type
+		FROM %[1]s JOIN %[2]s ON screening_type_id=%[2]s.id
+		JOIN %[3]s ON hall_id=%[3]s.id
+		JOIN %[4]s ON cinema_id=%[4]s.id
+		WHERE movie_id=$1 AND city_id=(SELECT city_id FROM %[4]s WHERE id=$2) AND %[1]s.id<>$3
+		AND start_time>=$4::TIMESTAMPTZ-make_interval(secs => $5) AND start_time<=$4::TIMESTAMPTZ+make_interval(secs => $5)
+		AND status=$6 AND NOW()>=sales_open_at AND NOW()<sales_close_at%[7]s
+		ORDER BY cinema_id<>$2, same_screening_type DESC, distance, ABS(EXTRACT(EPOCH FROM start_time-$4)), %[1]s.id
+		LIMIT $7`,
+		screeningsTableName, screeningTypeTableName, hallsTableName, cinemasTableName, screeningDetailsColumns,
+		screeningTypeName("$8"), filterCondition, geographyColumn(cinemasTableName+".coordinates"))
+
+	err = r.db.SelectContext(ctx, &alternatives, query, append([]any{screening.MovieID, screening.CinemaID,
+		screening.ScreeningID, screening.StartTime, timeWindow.Seconds(), models.ScreeningStatusScheduled, limit,
+		models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil || len(alternatives) == 0 {
+		return
+	}
+
+	ids := make([]int64, len(alternatives))
+	for i := range alternatives {
+		ids[i] = alternatives[i].ScreeningID
+	}
+	screeningsEvents, err := r.getScreeningsEvents(ctx, ids)
+	if err != nil {
+		return
+	}
+	for i := range alternatives {
+		alternatives[i].EventsIDs = screeningsEvents[alternatives[i].ScreeningID]
+	}
 	return
 }
 
 func (r *CinemaRepository) GetScreenings(ctx context.Context,
-	cinemaID, movieID int32, startPeriod, endPeriod time.Time) (screenings []models.Screening, err error) {
+	cinemaID, movieID int32, startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter) (screenings []models.Screening, err error) {
 	defer r.handleError(ctx, &err, "GetScreenings")
 
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 6)
 	query := fmt.Sprintf(`
-		SELECT %[1]s.id, movie_id, %[2]s.name AS screening_type, hall_id, ticket_price,start_time
+		SELECT %[1]s.id, movie_id, %[6]s AS screening_type, hall_id, ticket_price,start_time, cinema_id, %[5]s
 		FROM %[1]s JOIN %[2]s ON screening_type_id=%[2]s.id 
-		WHERE hall_id=ANY(SELECT id FROM %[3]s WHERE cinema_id=$1) AND movie_id=$2 AND start_time>=$3 AND start_time<=$4
+		JOIN %[3]s ON hall_id=%[3]s.id
+		WHERE cinema_id=$1 AND movie_id=$2 AND start_time>=$3 AND start_time<=$4%[4]s
 		ORDER BY start_time;`,
-		screeningsTableName, screeningTypeTableName, hallsTableName)
+		screeningsTableName, screeningTypeTableName, hallsTableName, filterCondition, screeningDetailsColumns,
+		screeningTypeName("$5"))
 
-	err = r.db.SelectContext(ctx, &screenings, query, cinemaID, movieID, startPeriod, endPeriod)
+	err = r.db.SelectContext(ctx, &screenings, query,
+		append([]any{cinemaID, movieID, startPeriod, endPeriod, models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil || len(screenings) == 0 {
+		return
+	}
+	err = r.fillScreeningsEvents(ctx, screenings)
+	return
+}
+
+func (r *CinemaRepository) GetCinemaUpcoming(ctx context.Context,
+	cinemaID int32, perMovieLimit int, filter models.ScreeningsFilter) (movies []models.MovieUpcomingScreenings, err error) {
+	defer r.handleError(ctx, &err, "GetCinemaUpcoming")
+
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 4)
+	// the next perMovieLimit screenings for each movie
+	query := fmt.Sprintf(`
+		WITH ranked AS (
+			SELECT %[1]s.id, ROW_NUMBER() OVER (PARTITION BY movie_id ORDER BY start_time, %[1]s.id) AS row_number
+			FROM %[1]s JOIN %[3]s ON hall_id=%[3]s.id
+			WHERE cinema_id=$1 AND start_time>=NOW()%[4]s
+		)
+		SELECT %[1]s.id, movie_id, %[6]s AS screening_type, hall_id, ticket_price, start_time, cinema_id, %[5]s
+		FROM %[1]s JOIN %[2]s ON screening_type_id=%[2]s.id
+		JOIN %[3]s ON hall_id=%[3]s.id
+		WHERE %[1]s.id IN (SELECT id FROM ranked WHERE row_number<=$2)
+		ORDER BY start_time, %[1]s.id`,
+		screeningsTableName, screeningTypeTableName, hallsTableName, filterCondition, screeningDetailsColumns,
+		screeningTypeName("$3"))
+
+	var screenings []models.Screening
+	err = r.db.SelectContext(ctx, &screenings, query,
+		append([]any{cinemaID, perMovieLimit, models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil || len(screenings) == 0 {
+		return
+	}
+	if err = r.fillScreeningsEvents(ctx, screenings); err != nil {
+		return
+	}
+
+	// movies are ordered by the nearest screening
+	moviesIndexes := make(map[int32]int)
+	for _, screening := range screenings {
+		i, ok := moviesIndexes[screening.MovieID]
+		if !ok {
+			i = len(movies)
+			moviesIndexes[screening.MovieID] = i
+			movies = append(movies, models.MovieUpcomingScreenings{MovieID: screening.MovieID})
+		}
+		movies[i].Screenings = append(movies[i].Screenings, screening)
+	}
 	return
 }
 
 func (r *CinemaRepository) GetScreening(ctx context.Context, id int64) (screening models.Screening, err error) {
 	defer r.handleError(ctx, &err, "GetScreening")
 	query := fmt.Sprintf(`
-	SELECT  %[2]s.name AS screening_type, hall_id, ticket_price, start_time, cinema_id, movie_id 
+	SELECT %[1]s.id, %[5]s AS screening_type, hall_id, ticket_price, start_time, cinema_id, movie_id, %[4]s
 	FROM %[1]s 
 	JOIN %[2]s ON screening_type_id=%[2]s.id 
 	JOIN %[3]s ON hall_id = %[3]s.id 
-	WHERE %[1]s.id=$1;`, screeningsTableName, screeningTypeTableName, hallsTableName)
+	WHERE %[1]s.id=$1;`, screeningsTableName, screeningTypeTableName, hallsTableName, screeningDetailsColumns,
+		screeningTypeName("$2"))
+
+	err = r.db.GetContext(ctx, &screening, query, id, models.LocaleFromContext(ctx))
+	if err != nil {
+		return
+	}
+
+	screeningsEvents, err := r.getScreeningsEvents(ctx, []int64{id})
+	screening.EventsIDs = screeningsEvents[id]
+	return
+}
+
+func (r *CinemaRepository) GetScreeningsByIds(ctx context.Context, ids []int64) (screenings []models.Screening, err error) {
+	defer r.handleError(ctx, &err, "GetScreeningsByIds")
+	query := fmt.Sprintf(`
+	SELECT %[1]s.id, %[5]s AS screening_type, hall_id, ticket_price, start_time, cinema_id, movie_id, %[4]s
+	FROM %[1]s
+	JOIN %[2]s ON screening_type_id=%[2]s.id
+	JOIN %[3]s ON hall_id = %[3]s.id
+	WHERE %[1]s.id=ANY($1)
+	ORDER BY %[1]s.id`, screeningsTableName, screeningTypeTableName, hallsTableName, screeningDetailsColumns,
+		screeningTypeName("$2"))
+
+	err = r.db.SelectContext(ctx, &screenings, query, ids, models.LocaleFromContext(ctx))
+	if err != nil || len(screenings) == 0 {
+		return
+	}
+
+	err = r.fillScreeningsEvents(ctx, screenings)
+	return
+}
+
+func (r *CinemaRepository) ListEvents(ctx context.Context, cityID int32) (events []models.Event, err error) {
+	defer r.handleError(ctx, &err, "ListEvents")
+
+	// events, that have screenings in the city cinemas and are not finished yet
+	query := fmt.Sprintf(`
+	SELECT id, name, description, start_date, end_date
+	FROM %[1]s
+	WHERE end_date>=CURRENT_DATE AND id IN (
+		SELECT event_id FROM %[2]s
+		JOIN %[3]s ON screening_id=%[3]s.id
+		JOIN %[4]s ON hall_id=%[4]s.id
+		JOIN %[5]s ON cinema_id=%[5]s.id
+		WHERE city_id=$1)
+	ORDER BY start_date, id`,
+		eventsTableName, eventsScreeningsTableName, screeningsTableName, hallsTableName, cinemasTableName)
+
+	err = r.db.SelectContext(ctx, &events, query, cityID)
+	return
+}
+
+func (r *CinemaRepository) GetEventScreenings(ctx context.Context,
+	eventID int32, filter models.ScreeningsFilter) (screenings []models.Screening, err error) {
+	defer r.handleError(ctx, &err, "GetEventScreenings")
+
+	filterCondition, filterArgs := screeningsFilterCondition(filter, 3)
+	query := fmt.Sprintf(`
+	SELECT %[1]s.id, movie_id, %[7]s AS screening_type, hall_id, ticket_price, start_time, cinema_id, %[6]s
+	FROM %[1]s
+	JOIN %[2]s ON screening_type_id=%[2]s.id
+	JOIN %[3]s ON hall_id=%[3]s.id
+	WHERE %[1]s.id IN (SELECT screening_id FROM %[4]s WHERE event_id=$1)%[5]s
+	ORDER BY start_time`,
+		screeningsTableName, screeningTypeTableName, hallsTableName, eventsScreeningsTableName,
+		filterCondition, screeningDetailsColumns, screeningTypeName("$2"))
+
+	err = r.db.SelectContext(ctx, &screenings, query, append([]any{eventID, models.LocaleFromContext(ctx)}, filterArgs...)...)
+	if err != nil {
+		return
+	}
+
+	if len(screenings) == 0 {
+		var exists bool
+		query = fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id=$1)", eventsTableName)
+		if err = r.db.GetContext(ctx, &exists, query, eventID); err != nil {
+			return
+		}
+		if !exists {
+			return nil, models.Error(models.NotFound, "event not found")
+		}
+		return
+	}
+
+	err = r.fillScreeningsEvents(ctx, screenings)
+	return
+}
+
+func (r *CinemaRepository) fillScreeningsEvents(ctx context.Context, screenings []models.Screening) error {
+	ids := make([]int64, len(screenings))
+	for i := range screenings {
+		ids[i] = screenings[i].ScreeningID
+	}
+
+	screeningsEvents, err := r.getScreeningsEvents(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range screenings {
+		screenings[i].EventsIDs = screeningsEvents[screenings[i].ScreeningID]
+	}
+	return nil
+}
+
+// getScreeningsEvents returns the events ids of the screenings, mapped by the screening id.
+func (r *CinemaRepository) getScreeningsEvents(ctx context.Context, ids []int64) (map[int64][]int32, error) {
+	query := fmt.Sprintf(`
+	SELECT screening_id, event_id
+	FROM %s
+	WHERE screening_id=ANY($1)
+	ORDER BY screening_id, event_id`, eventsScreeningsTableName)
+
+	var rows []struct {
+		ScreeningID int64 `db:"screening_id"`
+		EventID     int32 `db:"event_id"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, ids); err != nil {
+		return nil, err
+	}
+
+	screeningsEvents := make(map[int64][]int32, len(ids))
+	for _, row := range rows {
+		screeningsEvents[row.ScreeningID] = append(screeningsEvents[row.ScreeningID], row.EventID)
+	}
+	return screeningsEvents, nil
+}
+
+func (r *CinemaRepository) UpdateScreeningStatus(ctx context.Context, change models.ScreeningStatusChange) (err error) {
+	defer r.handleError(ctx, &err, "UpdateScreeningStatus")
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	// the status is changed only if it wasn't changed concurrently after the transition check
+	query := fmt.Sprintf(`
+	UPDATE %s SET status=$1, status_reason=$2, status_changed_at=NOW(), replacement_screening_id=$3
+	WHERE id=$4 AND status=$5`, screeningsTableName)
+	res, err := tx.ExecContext(ctx, query, change.Status, change.Reason, change.ReplacementScreeningID,
+		change.ScreeningID, change.PreviousStatus)
+	if err != nil {
+		return
+	}
+	if affected, _ := res.RowsAffected(); affected == 0 {
+		return models.Errorf(models.Conflict, "screening status was changed from %s concurrently", change.PreviousStatus)
+	}
 
-	err = r.db.GetContext(ctx, &screening, query, id)
+	query = fmt.Sprintf(`
+	INSERT INTO %s (screening_id, status, reason, replacement_screening_id)
+	VALUES ($1, $2, $3, $4)`, screeningsStatusesHistoryTableName)
+	_, err = tx.ExecContext(ctx, query, change.ScreeningID, change.Status, change.Reason, change.ReplacementScreeningID)
 	return
 }
 
 func (r *CinemaRepository) GetCinema(ctx context.Context, id int32) (cinema models.Cinema, err error) {
 	defer r.handleError(ctx, &err, "GetCinema")
 
-	query := fmt.Sprintf(`SELECT id,name,address, ST_AsText(coordinates) AS coordinates
-	FROM %s WHERE id=$1`, cinemasTableName)
+	query := fmt.Sprintf(`SELECT %s
+	FROM %s WHERE id=$1`, cinemaColumns("$2"), cinemasTableName)
+
+	err = r.db.GetContext(ctx, &cinema, query, id, models.LocaleFromContext(ctx))
+	if err != nil {
+		return
+	}
+
+	cinemas := []models.Cinema{cinema}
+	err = r.fillCinemasDetails(ctx, cinemas)
+	cinema = cinemas[0]
+	return
+}
+
+func (r *CinemaRepository) GetCinemas(ctx context.Context, ids []int32) (cinemas []models.Cinema, err error) {
+	defer r.handleError(ctx, &err, "GetCinemas")
 
-	err = r.db.GetContext(ctx, &cinema, query, id)
+	query := fmt.Sprintf(`
+	SELECT %s
+	FROM %s
+	WHERE id=ANY($1)
+	ORDER BY id`, cinemaColumns("$2"), cinemasTableName)
+
+	err = r.db.SelectContext(ctx, &cinemas, query, ids, models.LocaleFromContext(ctx))
+	if err != nil || len(cinemas) == 0 {
+		return
+	}
+	err = r.fillCinemasDetails(ctx, cinemas)
+	return
+}
+
+func (r *CinemaRepository) AddCinemaPhoto(ctx context.Context, cinemaID int32, url string) (cityID int32, err error) {
+	defer r.handleError(ctx, &err, "AddCinemaPhoto")
+
+	query := fmt.Sprintf(`
+	INSERT INTO %s (cinema_id, url) VALUES ($1, $2)
+	RETURNING (SELECT COALESCE(city_id, 0) FROM %s WHERE id=$1)`, cinemasPhotosTableName, cinemasTableName)
+	err = r.db.GetContext(ctx, &cityID, query, cinemaID, url)
+	return
+}
+
+func (r *CinemaRepository) CreateScreening(ctx context.Context, screening models.NewScreening) (id int64, err error) {
+	defer r.handleError(ctx, &err, "CreateScreening")
+
+	query := fmt.Sprintf(`
+	INSERT INTO %s (screening_type_id, movie_id, start_time, end_time, hall_id, ticket_price, %s)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	RETURNING id`, screeningsTableName, screeningAttributesColumns)
+
+	err = r.db.GetContext(ctx, &id, query, screening.ScreeningTypeID, screening.MovieID,
+		screening.StartTime, screening.EndTime, screening.HallID, screening.TicketPrice,
+		screening.SpokenLanguage, screening.SubtitlesLanguage, screening.OriginalLanguage,
+		screening.AudioDescription, screening.ClosedCaptions, screening.AgeRating, screening.Relaxed)
 	return
 }
 
 func (r *CinemaRepository) GetHalls(ctx context.Context, ids []int32) (halls []models.Hall, err error) {
 	defer r.handleError(ctx, &err, "GetHalls")
+	return r.getHalls(ctx, "id=ANY($1)", ids)
+}
+
+func (r *CinemaRepository) GetCinemaHalls(ctx context.Context, cinemaID int32) (halls []models.Hall, err error) {
+	defer r.handleError(ctx, &err, "GetCinemaHalls")
 
+	halls, err = r.getHalls(ctx, "cinema_id=$1", cinemaID)
+	if err != nil || len(halls) > 0 {
+		return
+	}
+
+	var exists bool
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id=$1)", cinemasTableName)
+	if err = r.db.GetContext(ctx, &exists, query, cinemaID); err != nil {
+		return
+	}
+	if !exists {
+		return nil, models.Error(models.NotFound, "cinema not found")
+	}
+	return
+}
+
+// getHalls returns the halls matching the condition, the condition argument is $1.
+func (r *CinemaRepository) getHalls(ctx context.Context, condition string, arg any) (halls []models.Hall, err error) {
 	query := fmt.Sprintf(`
-	SELECT id, COALESCE(%[1]s.name,'') AS hall_type, %[2]s.name AS name, hall_size AS size 
+	SELECT id, COALESCE(cinema_id, 0) AS cinema_id, COALESCE(%[4]s,'') AS hall_type, %[2]s.name AS name, hall_size AS size, accessible_size,
+	ARRAY(SELECT %[6]s AS name FROM %[3]s WHERE hall_id=%[2]s.id ORDER BY name) AS capabilities
 	FROM %[2]s 
 	LEFT JOIN %[1]s ON hall_type_id=type_id
-	WHERE id=ANY($1)`, hallsTypesTableName, hallsTableName)
-	err = r.db.SelectContext(ctx, &halls, query, ids)
+	WHERE %[5]s
+	ORDER BY id`, hallsTypesTableName, hallsTableName, hallsCapabilitiesNamesViewName,
+		localizedName(hallsTypesTableName, "type_id", hallsTypesTranslationsTableName, "$2"), condition,
+		hallCapabilityName("$2"))
+
+	var rows []hall
+	err = r.db.SelectContext(ctx, &rows, query, arg, models.LocaleFromContext(ctx))
+	if err != nil || len(rows) == 0 {
+		return
+	}
+
+	halls = make([]models.Hall, len(rows))
+	for i := range rows {
+		halls[i] = rows[i].Hall
+		halls[i].Capabilities = convertSQLArray(rows[i].Capabilities)
+	}
+	err = r.fillHallsCategories(ctx, halls)
 	return
 }
 
+func (r *CinemaRepository) CreateHallMaintenanceWindow(ctx context.Context,
+	window models.HallMaintenanceWindow) (id int32, err error) {
+	defer r.handleError(ctx, &err, "CreateHallMaintenanceWindow")
+
+	query := fmt.Sprintf(`
+	INSERT INTO %s (hall_id, start_time, end_time, reason)
+	VALUES ($1, $2, $3, $4)
+	RETURNING id`, hallsMaintenanceWindowsTableName)
+
+	err = r.db.GetContext(ctx, &id, query, window.HallID, window.StartTime, window.EndTime, window.Reason)
+	return
+}
+
+func (r *CinemaRepository) GetHallMaintenanceWindows(ctx context.Context,
+	hallID int32) (windows []models.HallMaintenanceWindow, err error) {
+	defer r.handleError(ctx, &err, "GetHallMaintenanceWindows")
+
+	query := fmt.Sprintf(`
+	SELECT id, hall_id, start_time, end_time, reason
+	FROM %s
+	WHERE hall_id=$1 AND end_time>NOW()
+	ORDER BY start_time`, hallsMaintenanceWindowsTableName)
+
+	err = r.db.SelectContext(ctx, &windows, query, hallID)
+	return
+}
+
+func (r *CinemaRepository) GetHallsCurrentMaintenance(ctx context.Context,
+	ids []int32) (windows []models.HallMaintenanceWindow, err error) {
+	defer r.handleError(ctx, &err, "GetHallsCurrentMaintenance")
+
+	query := fmt.Sprintf(`
+	SELECT DISTINCT ON (hall_id) id, hall_id, start_time, end_time, reason
+	FROM %s
+	WHERE hall_id=ANY($1) AND start_time<=NOW() AND end_time>NOW()
+	ORDER BY hall_id, end_time DESC`, hallsMaintenanceWindowsTableName)
+
+	err = r.db.SelectContext(ctx, &windows, query, ids)
+	return
+}
+
+// unknownScreeningDuration is the assumed duration of the screening without end time,
+// long enough for any feature movie with the advertising before it.
+const unknownScreeningDuration = "4 hours"
+
+func (r *CinemaRepository) GetHallScreeningsInPeriod(ctx context.Context,
+	hallID int32, start, end time.Time) (ids []int64, err error) {
+	defer r.handleError(ctx, &err, "GetHallScreeningsInPeriod")
+
+	// the movie runtime of the screenings without end time is unknown here,
+	// so they are assumed to last for the unknownScreeningDuration
+	query := fmt.Sprintf(`
+	SELECT id
+	FROM %s
+	WHERE hall_id=$1 AND status<>ALL($4) AND start_time<$3 AND COALESCE(end_time, start_time + INTERVAL '%s')>$2
+	ORDER BY start_time`, screeningsTableName, unknownScreeningDuration)
+
+	err = r.db.SelectContext(ctx, &ids, query, hallID, start, end,
+		[]string{string(models.ScreeningStatusCancelled), string(models.ScreeningStatusMoved)})
+	return
+}
+
+type hall struct {
+	models.Hall
+	Capabilities string `db:"capabilities"`
+}
+
+type hallCategoryCapacity struct {
+	HallID int32 `db:"hall_id"`
+	models.HallCategoryCapacity
+}
+
+func (r *CinemaRepository) fillHallsCategories(ctx context.Context, halls []models.Hall) error {
+	ids := make([]int32, len(halls))
+	for i := range halls {
+		ids[i] = halls[i].ID
+	}
+
+	query := fmt.Sprintf(`
+	SELECT hall_id, %[1]s.name AS category, capacity
+	FROM %[2]s
+	JOIN %[1]s ON category_id=%[1]s.id
+	WHERE hall_id=ANY($1)
+	ORDER BY hall_id, %[1]s.id`, placesCategoriesTableName, hallsCapacitiesTableName)
+
+	var capacities []hallCategoryCapacity
+	if err := r.db.SelectContext(ctx, &capacities, query, ids); err != nil {
+		return err
+	}
+
+	hallsCategories := make(map[int32][]models.HallCategoryCapacity, len(halls))
+	for _, capacity := range capacities {
+		hallsCategories[capacity.HallID] = append(hallsCategories[capacity.HallID], capacity.HallCategoryCapacity)
+	}
+	for i := range halls {
+		halls[i].Categories = hallsCategories[halls[i].ID]
+	}
+	return nil
+}
+
 func (r *CinemaRepository) GetHallConfiguraion(ctx context.Context, id int32) (places []models.Place, err error) {
 	defer r.handleError(ctx, &err, "GetHallConfiguraion")
 
@@ -248,13 +1185,47 @@ func (r *CinemaRepository) GetHallConfiguraion(ctx context.Context, id int32) (p
 	return
 }
 
+// convertSQLInt32Array converts postgres integer array representation to the slice.
+func convertSQLInt32Array(str string) []int32 {
+	elems := convertSQLArray(str)
+	nums := make([]int32, 0, len(elems))
+	for _, elem := range elems {
+		num, err := strconv.ParseInt(elem, 10, 32)
+		if err != nil {
+			continue
+		}
+		nums = append(nums, int32(num))
+	}
+	return nums
+}
+
+// convertSQLArray converts postgres text array representation to the slice, quoted elements are unquoted.
 func convertSQLArray(str string) []string {
-	if strings.EqualFold(str, "{NULL}") {
+	if strings.EqualFold(str, "{NULL}") || str == "{}" {
 		return []string{}
 	}
 
-	str = strings.Trim(str, "{}")
-	return strings.Split(str, ",")
+	str = strings.TrimSuffix(strings.TrimPrefix(str, "{"), "}")
+	var elems []string
+	var elem strings.Builder
+	var quoted, escaped bool
+	for _, r := range str {
+		switch {
+		case escaped:
+			elem.WriteRune(r)
+			escaped = false
+		case r == '\\' && quoted:
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			elems = append(elems, elem.String())
+			elem.Reset()
+		default:
+			elem.WriteRune(r)
+		}
+	}
+	return append(elems, elem.String())
 }
 
 func (r *CinemaRepository) handleError(ctx context.Context, err *error, functionName string) {
@@ -0,0 +1,47 @@
+package postgresrepository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+// cinemasFilterCondition returns the sql condition for the cinemas filter, prefixed with AND,
+// and its args. Placeholders are numbered starting from the firstArg. The OpenAt is not applied.
+func cinemasFilterCondition(filter models.CinemasFilter, firstArg int) (string, []any) {
+	var conditions []string
+	var args []any
+	nextArg := func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", firstArg+len(args)-1)
+	}
+
+	if len(filter.Amenities) > 0 {
+		// amenities are compared case insensitive, as in the models.CinemasFilter
+		amenities := make([]string, 0, len(filter.Amenities))
+		for _, amenity := range filter.Amenities {
+			amenity = strings.ToLower(amenity)
+			if !slices.Contains(amenities, amenity) {
+				amenities = append(amenities, amenity)
+			}
+		}
+
+		arg := nextArg(amenities)
+		conditions = append(conditions, fmt.Sprintf(`%[1]s.id IN (
+			SELECT cinema_id FROM %[2]s JOIN %[3]s ON amenity_id=%[3]s.id WHERE LOWER(name)=ANY(%[4]s)
+			GROUP BY cinema_id HAVING COUNT(DISTINCT LOWER(name))=cardinality(%[4]s::TEXT[]))`,
+			cinemasTableName, cinemasAmenitiesTableName, amenitiesTableName, arg))
+	}
+
+	if len(filter.ChainsIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s.chain_id=ANY(%s)",
+			cinemasTableName, nextArg(filter.ChainsIDs)))
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " AND " + strings.Join(conditions, " AND "), args
+}
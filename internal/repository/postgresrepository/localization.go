@@ -0,0 +1,28 @@
+package postgresrepository
+
+import "fmt"
+
+const (
+	countriesTranslationsTableName       = "countries_translations"
+	regionsTranslationsTableName         = "regions_translations"
+	citiesTranslationsTableName          = "cities_translations"
+	cinemasTranslationsTableName         = "cinemas_translations"
+	hallsTypesTranslationsTableName      = "halls_types_translations"
+	screeningsTypesTranslationsTableName = "screenings_types_translations"
+)
+
+// localizedName returns the sql expression of the entity name translated into the locale from the localeArg placeholder.
+// If there is no translation, the name in the default locale is returned.
+func localizedName(table, idColumn, translationsTable, localeArg string) string {
+	return fmt.Sprintf("COALESCE((SELECT %[3]s.name FROM %[3]s WHERE %[3]s.id=%[1]s.%[2]s AND %[3]s.locale=%[4]s), %[1]s.name)",
+		table, idColumn, translationsTable, localeArg)
+}
+
+// hallCapabilityName returns the name of the halls_capabilities_names view row, only the hall type name is translated.
+func hallCapabilityName(localeArg string) string {
+	return localizedName(hallsCapabilitiesNamesViewName, "type_id", hallsTypesTranslationsTableName, localeArg)
+}
+
+func screeningTypeName(localeArg string) string {
+	return localizedName(screeningTypeTableName, "id", screeningsTypesTranslationsTableName, localeArg)
+}
@@ -0,0 +1,69 @@
+package postgresrepository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const (
+	countriesTableName     = "countries"
+	regionsTableName       = "regions"
+	citiesSettingsViewName = "cities_settings"
+)
+
+// cityColumns returns the columns of the models.City, the query must contain citiesSettingsJoin.
+func cityColumns(localeArg string) string {
+	return fmt.Sprintf(`%[1]s.id, %[3]s AS name, COALESCE(%[1]s.region_id, 0) AS region_id,
+	%[2]s.currency, %[2]s.time_zone, %[2]s.locale`, citiesTableName, citiesSettingsViewName,
+		localizedName(citiesTableName, "id", citiesTranslationsTableName, localeArg))
+}
+
+// joins the city settings with the inherited country defaults
+var citiesSettingsJoin = fmt.Sprintf("JOIN %[2]s ON %[1]s.id=%[2]s.id", citiesTableName, citiesSettingsViewName)
+
+func (r *CinemaRepository) ListCountries(ctx context.Context) (countries []models.Country, err error) {
+	defer r.handleError(ctx, &err, "ListCountries")
+
+	query := fmt.Sprintf(`
+	SELECT id, %[2]s AS name, code, currency, time_zone, locale
+	FROM %[1]s
+	ORDER BY id`,
+		countriesTableName, localizedName(countriesTableName, "id", countriesTranslationsTableName, "$1"))
+
+	err = r.db.SelectContext(ctx, &countries, query, models.LocaleFromContext(ctx))
+	return
+}
+
+func (r *CinemaRepository) ListRegions(ctx context.Context, countryID int32) (regions []models.Region, err error) {
+	defer r.handleError(ctx, &err, "ListRegions")
+
+	query := fmt.Sprintf(`
+	SELECT id, country_id, %[2]s AS name
+	FROM %[1]s
+	WHERE country_id=$1
+	ORDER BY id`,
+		regionsTableName, localizedName(regionsTableName, "id", regionsTranslationsTableName, "$2"))
+
+	err = r.db.SelectContext(ctx, &regions, query, countryID, models.LocaleFromContext(ctx))
+	return
+}
+
+func (r *CinemaRepository) GetRegionCities(ctx context.Context, regionID int32) (cities []models.City, err error) {
+	defer r.handleError(ctx, &err, "GetRegionCities")
+
+	query := fmt.Sprintf("SELECT %[2]s FROM %[1]s %[3]s WHERE %[1]s.region_id=$1 AND %[4]s ORDER BY %[1]s.id",
+		citiesTableName, cityColumns("$2"), citiesSettingsJoin, cityHasCinemasCondition)
+
+	err = r.db.SelectContext(ctx, &cities, query, regionID, models.LocaleFromContext(ctx))
+	return
+}
+
+func (r *CinemaRepository) GetRegionsCitiesIDs(ctx context.Context, regionsIDs []int32) (ids []int32, err error) {
+	defer r.handleError(ctx, &err, "GetRegionsCitiesIDs")
+
+	query := fmt.Sprintf("SELECT id FROM %s WHERE region_id=ANY($1) ORDER BY id", citiesTableName)
+	err = r.db.SelectContext(ctx, &ids, query, regionsIDs)
+	return
+}
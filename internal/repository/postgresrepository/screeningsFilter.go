@@ -0,0 +1,81 @@
+package postgresrepository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const hallsCapabilitiesNamesViewName = "halls_capabilities_names"
+
+// screeningsFilterCondition returns the sql condition for the screenings filter, prefixed with AND,
+// and its args. Placeholders are numbered starting from the firstArg.
+func screeningsFilterCondition(filter models.ScreeningsFilter, firstArg int) (string, []any) {
+	var conditions []string
+	var args []any
+	nextArg := func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", firstArg+len(args)-1)
+	}
+
+	// the capabilities names may be in any locale, the hall types names are matched with their translations too
+	if len(filter.HallsCapabilities) > 0 {
+		capabilities := nextArg(filter.HallsCapabilities)
+		conditions = append(conditions, fmt.Sprintf(`%[1]s.hall_id IN (
+			SELECT hall_id FROM %[2]s JOIN unnest(%[3]s::TEXT[]) AS requested(name)
+			ON %[2]s.name=requested.name
+			OR requested.name IN (SELECT %[4]s.name FROM %[4]s WHERE %[4]s.id=%[2]s.type_id)
+			GROUP BY hall_id HAVING COUNT(DISTINCT requested.name)=cardinality(%[3]s::TEXT[]))`,
+			screeningsTableName, hallsCapabilitiesNamesViewName, capabilities, hallsTypesTranslationsTableName))
+	}
+
+	if filter.SpokenLanguage != "" {
+		conditions = append(conditions, fmt.Sprintf("%s.spoken_language=LOWER(%s)",
+			screeningsTableName, nextArg(filter.SpokenLanguage)))
+	}
+	if filter.SubtitlesLanguage != "" {
+		conditions = append(conditions, fmt.Sprintf("%s.subtitles_language=LOWER(%s)",
+			screeningsTableName, nextArg(filter.SubtitlesLanguage)))
+	}
+	if len(filter.AgeRatings) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s.age_rating=ANY(%s)",
+			screeningsTableName, nextArg(filter.AgeRatings)))
+	}
+
+	flags := []struct {
+		column  string
+		enabled bool
+	}{
+		{column: "original_language", enabled: filter.OriginalLanguage},
+		{column: "audio_description", enabled: filter.AudioDescription},
+		{column: "closed_captions", enabled: filter.ClosedCaptions},
+		{column: "relaxed", enabled: filter.Relaxed},
+	}
+	for _, flag := range flags {
+		if flag.enabled {
+			conditions = append(conditions, fmt.Sprintf("%s.%s", screeningsTableName, flag.column))
+		}
+	}
+
+	if !filter.IncludeCancelled {
+		conditions = append(conditions, fmt.Sprintf("%s.status<>ALL(%s)",
+			screeningsTableName, nextArg([]string{string(models.ScreeningStatusCancelled), string(models.ScreeningStatusMoved)})))
+	}
+
+	if filter.PurchasableOnly {
+		conditions = append(conditions, fmt.Sprintf("%[1]s.status=%[2]s AND NOW()>=%[1]s.sales_open_at AND NOW()<%[1]s.sales_close_at",
+			screeningsTableName, nextArg(string(models.ScreeningStatusScheduled))))
+	}
+
+	if len(filter.ChainsIDs) > 0 {
+		conditions = append(conditions, fmt.Sprintf(`%[1]s.hall_id IN (
+			SELECT %[2]s.id FROM %[2]s JOIN %[3]s ON %[2]s.cinema_id=%[3]s.id WHERE chain_id=ANY(%[4]s))`,
+			screeningsTableName, hallsTableName, cinemasTableName, nextArg(filter.ChainsIDs)))
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " AND " + strings.Join(conditions, " AND "), args
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Falokut/cinema_service/internal/models"
@@ -21,6 +23,8 @@ type CinemaCache struct {
 	hallsRdb               *redis.Client
 	cinemasRdb             *redis.Client
 	metrics                Metrics
+	// all locales of the cached values keys, the empty locale is used if the request locale is not set
+	locales []string
 }
 
 func NewCinemaCache(logger *logrus.Logger,
@@ -29,7 +33,16 @@ func NewCinemaCache(logger *logrus.Logger,
 	citiesRdb,
 	hallsConfigurationsRdb,
 	hallsRdb *redis.Client,
-	metrics Metrics) *CinemaCache {
+	metrics Metrics,
+	locales []string) *CinemaCache {
+	keysLocales := []string{""}
+	for _, locale := range locales {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if !slices.Contains(keysLocales, locale) {
+			keysLocales = append(keysLocales, locale)
+		}
+	}
+
 	return &CinemaCache{
 		logger:                 logger,
 		citiesCinemasRdb:       cinemasCitiesRdb,
@@ -38,6 +51,7 @@ func NewCinemaCache(logger *logrus.Logger,
 		hallsConfigurationsRdb: hallsConfigurationsRdb,
 		hallsRdb:               hallsRdb,
 		metrics:                metrics,
+		locales:                keysLocales,
 	}
 }
 
@@ -64,7 +78,7 @@ func (c *CinemaCache) GetCinema(ctx context.Context, id int32) (cinema models.Ci
 	defer c.updateMetrics(&err, "GetCinema")
 	defer handleError(ctx, &err)
 	defer c.logError(&err, "GetCinema")
-	data, err := c.cinemasRdb.Get(ctx, fmt.Sprint(id)).Bytes()
+	data, err := c.cinemasRdb.Get(ctx, localizedKey(ctx, id)).Bytes()
 	if err != nil {
 		return
 	}
@@ -85,15 +99,86 @@ func (c *CinemaCache) CacheCinema(ctx context.Context, cinema models.Cinema, ttl
 		return err
 	}
 
-	err = c.cinemasRdb.Set(ctx, fmt.Sprint(cinema.ID), data, ttl).Err()
+	err = c.cinemasRdb.Set(ctx, localizedKey(ctx, cinema.ID), data, ttl).Err()
 	return err
 }
 
+func (c *CinemaCache) GetCinemas(ctx context.Context, ids []int32) (cinemas []models.Cinema, notFoundedIds []int32, err error) {
+	defer c.updateMetrics(&err, "GetCinemas")
+	defer handleError(ctx, &err)
+	defer c.logError(&err, "GetCinemas")
+	keys := make([]string, len(ids))
+	cinemasIds := make(map[int32]struct{}, len(ids))
+	for i, id := range ids {
+		keys[i] = localizedKey(ctx, id)
+		cinemasIds[id] = struct{}{}
+	}
+
+	cinemasBody, err := c.cinemasRdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return
+	}
+
+	cinemas = make([]models.Cinema, 0, len(cinemasBody))
+	for _, cached := range cinemasBody {
+		if cached == nil {
+			continue
+		}
+
+		cinema := models.Cinema{}
+		err = json.Unmarshal([]byte(cached.(string)), &cinema)
+		if err != nil {
+			return
+		}
+		delete(cinemasIds, cinema.ID)
+		cinemas = append(cinemas, cinema)
+	}
+
+	return cinemas, maps.Keys(cinemasIds), nil
+}
+
+func (c *CinemaCache) CacheCinemas(ctx context.Context, cinemas []models.Cinema, ttl time.Duration) (err error) {
+	defer handleError(ctx, &err)
+	defer c.logError(&err, "CacheCinemas")
+	tx := c.cinemasRdb.Pipeline()
+	for _, cinema := range cinemas {
+		toCache, merr := json.Marshal(cinema)
+		if merr != nil {
+			err = merr
+			return
+		}
+		tx.Set(ctx, localizedKey(ctx, cinema.ID), toCache, ttl)
+	}
+	_, err = tx.Exec(ctx)
+	return
+}
+
+func (c *CinemaCache) DeleteCinema(ctx context.Context, cinemaID, cityID int32) (err error) {
+	defer handleError(ctx, &err)
+	defer c.logError(&err, "DeleteCinema")
+	if err = c.cinemasRdb.Del(ctx, c.allLocalesKeys(cinemaID)...).Err(); err != nil {
+		return
+	}
+	if cityID == 0 {
+		return
+	}
+	return c.citiesCinemasRdb.Del(ctx, c.allLocalesKeys(cityID)...).Err()
+}
+
+// allLocalesKeys returns the keys of the id for all locales.
+func (c *CinemaCache) allLocalesKeys(id int32) []string {
+	keys := make([]string, len(c.locales))
+	for i, locale := range c.locales {
+		keys[i] = fmt.Sprint(locale, ":", id)
+	}
+	return keys
+}
+
 func (c *CinemaCache) GetCinemasInCity(ctx context.Context, cityID int32) (cinemas []models.Cinema, err error) {
 	defer c.updateMetrics(&err, "GetCinemasInCity")
 	defer handleError(ctx, &err)
 	defer c.logError(&err, "GetCinemasInCity")
-	data, err := c.citiesCinemasRdb.Get(ctx, fmt.Sprint(cityID)).Bytes()
+	data, err := c.citiesCinemasRdb.Get(ctx, localizedKey(ctx, cityID)).Bytes()
 	if err != nil {
 		return
 	}
@@ -111,10 +196,16 @@ func (c *CinemaCache) GetCinemasCities(ctx context.Context) (cities []models.Cit
 	defer handleError(ctx, &err)
 	defer c.logError(&err, "GetCinemasCities")
 	var keys []string
-	err = c.citiesRdb.Keys(ctx, "*").ScanSlice(&keys)
-	if err != nil {
+	iter := c.citiesRdb.Scan(ctx, 0, localizedKey(ctx, "*"), 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err = iter.Err(); err != nil {
 		return
 	}
+	if len(keys) == 0 {
+		return nil, redis.Nil
+	}
 
 	redisData, err := c.citiesRdb.MGet(ctx, keys...).Result()
 	if err != nil {
@@ -160,7 +251,7 @@ func (c *CinemaCache) CacheCinemasInCity(ctx context.Context, id int32, cinemas
 		return
 	}
 
-	err = c.citiesCinemasRdb.Set(ctx, fmt.Sprint(id), data, ttl).Err()
+	err = c.citiesCinemasRdb.Set(ctx, localizedKey(ctx, id), data, ttl).Err()
 	return
 }
 
@@ -174,7 +265,7 @@ func (c *CinemaCache) CacheCinemasCities(ctx context.Context, cities []models.Ci
 			err = merr
 			return
 		}
-		tx.Set(ctx, fmt.Sprint(city.ID), toCache, ttl)
+		tx.Set(ctx, localizedKey(ctx, city.ID), toCache, ttl)
 	}
 	_, err = tx.Exec(ctx)
 	return
@@ -202,7 +293,7 @@ func (c *CinemaCache) CacheHalls(ctx context.Context, halls []models.Hall, ttl t
 			err = merr
 			return
 		}
-		err = tx.Set(ctx, fmt.Sprint(hall.ID), toCache, ttl).Err()
+		err = tx.Set(ctx, localizedKey(ctx, hall.ID), toCache, ttl).Err()
 		if err != nil {
 			return
 		}
@@ -218,7 +309,7 @@ func (c *CinemaCache) GetHalls(ctx context.Context, ids []int32) (halls []models
 	keys := make([]string, len(ids))
 	hallsIds := make(map[int32]struct{}, len(ids))
 	for i, id := range ids {
-		keys[i] = fmt.Sprint(id)
+		keys[i] = localizedKey(ctx, id)
 		hallsIds[id] = struct{}{}
 	}
 
@@ -245,6 +336,45 @@ func (c *CinemaCache) GetHalls(ctx context.Context, ids []int32) (halls []models
 	return halls, maps.Keys(hallsIds), nil
 }
 
+func (c *CinemaCache) GetCinemaHalls(ctx context.Context, cinemaID int32) (halls []models.Hall, err error) {
+	defer c.updateMetrics(&err, "GetCinemaHalls")
+	defer handleError(ctx, &err)
+	defer c.logError(&err, "GetCinemaHalls")
+	data, err := c.hallsRdb.Get(ctx, cinemaHallsKey(ctx, cinemaID)).Bytes()
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &halls)
+	if err != nil {
+		return
+	}
+
+	return halls, nil
+}
+
+func (c *CinemaCache) CacheCinemaHalls(ctx context.Context, cinemaID int32, halls []models.Hall, ttl time.Duration) (err error) {
+	defer handleError(ctx, &err)
+	defer c.logError(&err, "CacheCinemaHalls")
+	data, err := json.Marshal(halls)
+	if err != nil {
+		return
+	}
+
+	err = c.hallsRdb.Set(ctx, cinemaHallsKey(ctx, cinemaID), data, ttl).Err()
+	return
+}
+
+// cinemaHallsKey returns the key of the cinema halls list, halls are stored in the same db by the hall id.
+func cinemaHallsKey(ctx context.Context, cinemaID int32) string {
+	return localizedKey(ctx, fmt.Sprint("cinema:", cinemaID))
+}
+
+// localizedKey returns the key of the cached value with translated names, values are cached per locale.
+func localizedKey(ctx context.Context, id any) string {
+	return fmt.Sprint(models.LocaleFromContext(ctx), ":", id)
+}
+
 func (c *CinemaCache) logError(errptr *error, functionName string) {
 	if errptr == nil || *errptr == nil {
 		return
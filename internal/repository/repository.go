@@ -26,19 +26,24 @@ type CinemaRepository interface {
 	GetCinemasCities(ctx context.Context) ([]models.City, error)
 
 	// Returns all movies that are in the cinema screenings in a particular cinema.
-	GetMoviesScreenings(ctx context.Context, cinemaID int32, startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error)
+	GetMoviesScreenings(ctx context.Context, cinemaID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter) ([]models.MoviesScreenings, error)
 
 	// Returns all screenings for a movie in a specific city.
-	GetCityScreenings(ctx context.Context, cityID, movieID int32, startPeriod, endPeriod time.Time) ([]models.CityScreening, error)
+	GetCityScreenings(ctx context.Context, cityID, movieID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter) ([]models.CityScreening, error)
 
-	// Returns all movies that are in the cinema screenings.
-	GetAllMoviesScreenings(ctx context.Context, startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error)
+	// Returns all movies that are in the cinema screenings, the stats are aggregated only if includeStats is true.
+	GetAllMoviesScreenings(ctx context.Context, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter, includeStats bool) ([]models.MoviesScreenings, error)
 
 	// Returns all movies that are in the cinema screenings in particular cities.
-	GetMoviesScreeningsInCities(ctx context.Context, citiesIDs []int32, startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error)
+	GetMoviesScreeningsInCities(ctx context.Context, citiesIDs []int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter, includeStats bool) ([]models.MoviesScreenings, error)
 
 	// Returns all screenings for a movie in a specific cinema.
-	GetScreenings(ctx context.Context, cinemaID, movieID int32, startPeriod, endPeriod time.Time) ([]models.Screening, error)
+	GetScreenings(ctx context.Context, cinemaID, movieID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter) ([]models.Screening, error)
 
 	// Returns the configuration of the hall.
 	GetHallConfiguraion(ctx context.Context, id int32) ([]models.Place, error)
@@ -46,8 +51,92 @@ type CinemaRepository interface {
 	// Returns info for the halls rith specified ids (without configuration).
 	GetHalls(ctx context.Context, ids []int32) ([]models.Hall, error)
 
+	// Returns all halls of the cinema (without configuration).
+	GetCinemaHalls(ctx context.Context, cinemaID int32) ([]models.Hall, error)
+
 	// Returns cinema rith specified id.
 	GetCinema(ctx context.Context, id int32) (models.Cinema, error)
+
+	// Returns cinemas with specified ids, not found cinemas are not included.
+	GetCinemas(ctx context.Context, ids []int32) ([]models.Cinema, error)
+
+	// Returns screenings with specified ids, not found screenings are not included.
+	GetScreeningsByIds(ctx context.Context, ids []int64) ([]models.Screening, error)
+
+	// returns Conflict error if the screening status is no longer the change.PreviousStatus.
+	// returns Conflict error if the screening status isn't the change previous status.
+	UpdateScreeningStatus(ctx context.Context, change models.ScreeningStatusChange) error
+
+	// Returns not finished events with screenings in the city.
+	ListEvents(ctx context.Context, cityID int32) ([]models.Event, error)
+
+	// Returns screenings of the event.
+	GetEventScreenings(ctx context.Context, eventID int32, filter models.ScreeningsFilter) ([]models.Screening, error)
+
+	// Creates the screening and returns its id.
+	CreateScreening(ctx context.Context, screening models.NewScreening) (int64, error)
+
+	// Creates the hall maintenance window and returns its id.
+	CreateHallMaintenanceWindow(ctx context.Context, window models.HallMaintenanceWindow) (int32, error)
+
+	// Returns current and upcoming maintenance windows of the hall.
+	GetHallMaintenanceWindows(ctx context.Context, hallID int32) ([]models.HallMaintenanceWindow, error)
+
+	// Returns maintenance windows, that are active now, for the halls with specified ids.
+	GetHallsCurrentMaintenance(ctx context.Context, ids []int32) ([]models.HallMaintenanceWindow, error)
+
+	// Returns ids of the not cancelled and not moved hall screenings, that intersect the period,
+	// screenings without end time are assumed to last for several hours.
+	GetHallScreeningsInPeriod(ctx context.Context, hallID int32, start, end time.Time) ([]int64, error)
+
+	// Saves the url of the uploaded cinema photo, returns the cinema city id, 0 if the cinema is without city.
+	AddCinemaPhoto(ctx context.Context, cinemaID int32, url string) (int32, error)
+
+	// Returns chains, that have cinemas in the city.
+	ListChains(ctx context.Context, cityID int32) ([]models.Chain, error)
+
+	// Returns cities and cinemas, that match the query, ordered by the similarity.
+	// If cityID is not nil, only the city and its cinemas are searched.
+	Search(ctx context.Context, query string, cityID *int32, limit int) ([]models.SearchResult, error)
+
+	// Returns cities, which name starts with the prefix in latin or cyrillic, cities with cinemas go first.
+	AutocompleteCities(ctx context.Context, prefix string, limit int) ([]models.CitySuggestion, error)
+
+	// Returns the cinemas in the bounds, the filter OpenAt is not applied.
+	GetCinemasInBounds(ctx context.Context, bounds models.GeoBounds, filter models.CinemasFilter) ([]models.Cinema, error)
+	// Groups the cinemas in the bounds by the grid cells with the gridSize in degrees, the filter OpenAt is not applied.
+	GetCinemasClustersInBounds(ctx context.Context, bounds models.GeoBounds,
+		gridSize float64, filter models.CinemasFilter) ([]models.CinemasCluster, error)
+
+	// Calls fn for each cinema matching the filter, stops on the first fn error.
+	ExportCinemas(ctx context.Context, filter models.CinemasExportFilter,
+		format models.GeoFormat, fn func(models.CinemaFeature) error) error
+
+	// Returns the city which boundary contains the point, or the city of the nearest cinema.
+	ResolveCity(ctx context.Context, point models.GeoPoint) (models.ResolvedCity, error)
+
+	ListCountries(ctx context.Context) ([]models.Country, error)
+	ListRegions(ctx context.Context, countryID int32) ([]models.Region, error)
+	// Returns the region cities where there are cinemas.
+	GetRegionCities(ctx context.Context, regionID int32) ([]models.City, error)
+	// Returns ids of all cities in the regions.
+	GetRegionsCitiesIDs(ctx context.Context, regionsIDs []int32) ([]int32, error)
+
+	// Returns movies which first upcoming screening in the city is within the horizon.
+	GetNowShowing(ctx context.Context, cityID int32, horizonDays uint32,
+		filter models.ScreeningsFilter) ([]models.CityMovie, error)
+	// Returns movies which first upcoming screening in the city is beyond the horizon.
+	GetComingSoon(ctx context.Context, cityID int32, horizonDays uint32,
+		filter models.ScreeningsFilter) ([]models.CityMovie, error)
+
+	// Returns the next perMovieLimit screenings of each movie in the cinema.
+	GetCinemaUpcoming(ctx context.Context, cinemaID int32, perMovieLimit int,
+		filter models.ScreeningsFilter) ([]models.MovieUpcomingScreenings, error)
+
+	// Returns the purchasable screenings of the same movie in the screening city,
+	// which start within the time window around the screening start time.
+	GetAlternativeScreenings(ctx context.Context, screening models.Screening,
+		timeWindow time.Duration, limit int, filter models.ScreeningsFilter) ([]models.AlternativeScreening, error)
 }
 
 type CinemaCache interface {
@@ -63,14 +152,25 @@ type CinemaCache interface {
 	// Returns info for the halls rith specified ids and not founded ids (rithout configuration).
 	GetHalls(ctx context.Context, ids []int32) ([]models.Hall, []int32, error)
 
+	// Returns all halls of the cinema (without configuration).
+	GetCinemaHalls(ctx context.Context, cinemaID int32) ([]models.Hall, error)
+
 	// Returns cinema rith specified id.
 	GetCinema(ctx context.Context, id int32) (models.Cinema, error)
 
+	// Returns cinemas with specified ids and not founded ids.
+	GetCinemas(ctx context.Context, ids []int32) ([]models.Cinema, []int32, error)
+
 	CacheCinemasInCity(ctx context.Context, id int32, cinemas []models.Cinema, ttl time.Duration) error
 	CacheCinemasCities(ctx context.Context, cities []models.City, ttl time.Duration) error
 	CacheHallConfiguraion(ctx context.Context, id int32, places []models.Place, ttl time.Duration) error
 	CacheHalls(ctx context.Context, halls []models.Hall, ttl time.Duration) error
+	CacheCinemaHalls(ctx context.Context, cinemaID int32, halls []models.Hall, ttl time.Duration) error
 	CacheCinema(ctx context.Context, cinema models.Cinema, ttl time.Duration) error
+	CacheCinemas(ctx context.Context, cinemas []models.Cinema, ttl time.Duration) error
+
+	// Deletes the cached cinema and the cached cinemas of its city for all locales.
+	DeleteCinema(ctx context.Context, cinemaID, cityID int32) error
 }
 
 type CacheConfig struct {
@@ -119,7 +219,7 @@ func (r *cinemaRepositoryWithCache) GetCinemasInCity(ctx context.Context, id int
 	}
 
 	go func() {
-		err := r.cache.CacheCinemasInCity(context.Background(), id, cinemas, r.cacheCfg.CitiesCinemasTTL)
+		err := r.cache.CacheCinemasInCity(context.WithoutCancel(ctx), id, cinemas, r.cacheCfg.CitiesCinemasTTL)
 		if err != nil {
 			r.logger.Errorf("error rhile caching cinemas in city, %s", err)
 		}
@@ -129,36 +229,159 @@ func (r *cinemaRepositoryWithCache) GetCinemasInCity(ctx context.Context, id int
 }
 
 func (r *cinemaRepositoryWithCache) GetMoviesScreenings(ctx context.Context, cinemaID int32,
-	startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error) {
-	return r.repo.GetMoviesScreenings(ctx, cinemaID, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter) ([]models.MoviesScreenings, error) {
+	return r.repo.GetMoviesScreenings(ctx, cinemaID, startPeriod, endPeriod, filter)
 }
 
 func (r *cinemaRepositoryWithCache) GetAllMoviesScreenings(ctx context.Context,
-	startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error) {
-	return r.repo.GetAllMoviesScreenings(ctx, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter, includeStats bool) ([]models.MoviesScreenings, error) {
+	return r.repo.GetAllMoviesScreenings(ctx, startPeriod, endPeriod, filter, includeStats)
 }
 
 func (r *cinemaRepositoryWithCache) GetMoviesScreeningsInCities(ctx context.Context, citiesIDs []int32,
-	startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error) {
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter, includeStats bool) ([]models.MoviesScreenings, error) {
 	return r.repo.GetMoviesScreeningsInCities(ctx, citiesIDs,
-		startPeriod, endPeriod)
+		startPeriod, endPeriod, filter, includeStats)
 }
 
 func (r *cinemaRepositoryWithCache) GetScreenings(ctx context.Context, cinemaID, movieID int32,
-	startPeriod, endPeriod time.Time) ([]models.Screening, error) {
-	return r.repo.GetScreenings(ctx, cinemaID, movieID, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter) ([]models.Screening, error) {
+	return r.repo.GetScreenings(ctx, cinemaID, movieID, startPeriod, endPeriod, filter)
 }
 
 func (r *cinemaRepositoryWithCache) GetCityScreenings(ctx context.Context, cityID, movieID int32,
-	startPeriod, endPeriod time.Time) ([]models.CityScreening, error) {
+	startPeriod, endPeriod time.Time, filter models.ScreeningsFilter) ([]models.CityScreening, error) {
 	return r.repo.GetCityScreenings(ctx, cityID, movieID,
-		startPeriod, endPeriod)
+		startPeriod, endPeriod, filter)
 }
 
 func (r *cinemaRepositoryWithCache) GetScreening(ctx context.Context, id int64) (models.Screening, error) {
 	return r.repo.GetScreening(ctx, id)
 }
 
+func (r *cinemaRepositoryWithCache) UpdateScreeningStatus(ctx context.Context, change models.ScreeningStatusChange) error {
+	return r.repo.UpdateScreeningStatus(ctx, change)
+}
+
+func (r *cinemaRepositoryWithCache) ListEvents(ctx context.Context, cityID int32) ([]models.Event, error) {
+	return r.repo.ListEvents(ctx, cityID)
+}
+
+func (r *cinemaRepositoryWithCache) GetEventScreenings(ctx context.Context,
+	eventID int32, filter models.ScreeningsFilter) ([]models.Screening, error) {
+	return r.repo.GetEventScreenings(ctx, eventID, filter)
+}
+
+func (r *cinemaRepositoryWithCache) CreateScreening(ctx context.Context, screening models.NewScreening) (int64, error) {
+	return r.repo.CreateScreening(ctx, screening)
+}
+
+func (r *cinemaRepositoryWithCache) CreateHallMaintenanceWindow(ctx context.Context,
+	window models.HallMaintenanceWindow) (int32, error) {
+	return r.repo.CreateHallMaintenanceWindow(ctx, window)
+}
+
+func (r *cinemaRepositoryWithCache) GetHallMaintenanceWindows(ctx context.Context,
+	hallID int32) ([]models.HallMaintenanceWindow, error) {
+	return r.repo.GetHallMaintenanceWindows(ctx, hallID)
+}
+
+func (r *cinemaRepositoryWithCache) GetHallsCurrentMaintenance(ctx context.Context,
+	ids []int32) ([]models.HallMaintenanceWindow, error) {
+	return r.repo.GetHallsCurrentMaintenance(ctx, ids)
+}
+
+func (r *cinemaRepositoryWithCache) GetHallScreeningsInPeriod(ctx context.Context,
+	hallID int32, start, end time.Time) ([]int64, error) {
+	return r.repo.GetHallScreeningsInPeriod(ctx, hallID, start, end)
+}
+
+func (r *cinemaRepositoryWithCache) AddCinemaPhoto(ctx context.Context, cinemaID int32, url string) (int32, error) {
+	cityID, err := r.repo.AddCinemaPhoto(ctx, cinemaID, url)
+	if err != nil {
+		return cityID, err
+	}
+
+	// the photo is saved, so the cache error is only logged, the cache will be updated after the ttl
+	if err := r.cache.DeleteCinema(ctx, cinemaID, cityID); err != nil {
+		r.logger.Errorf("error rhile deleting cached cinema, %s", err)
+	}
+	return cityID, nil
+}
+
+func (r *cinemaRepositoryWithCache) ListChains(ctx context.Context, cityID int32) ([]models.Chain, error) {
+	return r.repo.ListChains(ctx, cityID)
+}
+
+func (r *cinemaRepositoryWithCache) Search(ctx context.Context, query string,
+	cityID *int32, limit int) ([]models.SearchResult, error) {
+	return r.repo.Search(ctx, query, cityID, limit)
+}
+
+func (r *cinemaRepositoryWithCache) AutocompleteCities(ctx context.Context,
+	prefix string, limit int) ([]models.CitySuggestion, error) {
+	return r.repo.AutocompleteCities(ctx, prefix, limit)
+}
+
+func (r *cinemaRepositoryWithCache) GetCinemasInBounds(ctx context.Context,
+	bounds models.GeoBounds, filter models.CinemasFilter) ([]models.Cinema, error) {
+	return r.repo.GetCinemasInBounds(ctx, bounds, filter)
+}
+
+func (r *cinemaRepositoryWithCache) GetCinemasClustersInBounds(ctx context.Context,
+	bounds models.GeoBounds, gridSize float64, filter models.CinemasFilter) ([]models.CinemasCluster, error) {
+	return r.repo.GetCinemasClustersInBounds(ctx, bounds, gridSize, filter)
+}
+
+func (r *cinemaRepositoryWithCache) ExportCinemas(ctx context.Context, filter models.CinemasExportFilter,
+	format models.GeoFormat, fn func(models.CinemaFeature) error) error {
+	return r.repo.ExportCinemas(ctx, filter, format, fn)
+}
+
+func (r *cinemaRepositoryWithCache) ResolveCity(ctx context.Context, point models.GeoPoint) (models.ResolvedCity, error) {
+	return r.repo.ResolveCity(ctx, point)
+}
+
+func (r *cinemaRepositoryWithCache) ListCountries(ctx context.Context) ([]models.Country, error) {
+	return r.repo.ListCountries(ctx)
+}
+
+func (r *cinemaRepositoryWithCache) ListRegions(ctx context.Context, countryID int32) ([]models.Region, error) {
+	return r.repo.ListRegions(ctx, countryID)
+}
+
+func (r *cinemaRepositoryWithCache) GetRegionCities(ctx context.Context, regionID int32) ([]models.City, error) {
+	return r.repo.GetRegionCities(ctx, regionID)
+}
+
+func (r *cinemaRepositoryWithCache) GetRegionsCitiesIDs(ctx context.Context, regionsIDs []int32) ([]int32, error) {
+	return r.repo.GetRegionsCitiesIDs(ctx, regionsIDs)
+}
+
+func (r *cinemaRepositoryWithCache) GetNowShowing(ctx context.Context,
+	cityID int32, horizonDays uint32, filter models.ScreeningsFilter) ([]models.CityMovie, error) {
+	return r.repo.GetNowShowing(ctx, cityID, horizonDays, filter)
+}
+
+func (r *cinemaRepositoryWithCache) GetComingSoon(ctx context.Context,
+	cityID int32, horizonDays uint32, filter models.ScreeningsFilter) ([]models.CityMovie, error) {
+	return r.repo.GetComingSoon(ctx, cityID, horizonDays, filter)
+}
+
+func (r *cinemaRepositoryWithCache) GetCinemaUpcoming(ctx context.Context,
+	cinemaID int32, perMovieLimit int, filter models.ScreeningsFilter) ([]models.MovieUpcomingScreenings, error) {
+	return r.repo.GetCinemaUpcoming(ctx, cinemaID, perMovieLimit, filter)
+}
+
+func (r *cinemaRepositoryWithCache) GetAlternativeScreenings(ctx context.Context, screening models.Screening,
+	timeWindow time.Duration, limit int, filter models.ScreeningsFilter) ([]models.AlternativeScreening, error) {
+	return r.repo.GetAlternativeScreenings(ctx, screening, timeWindow, limit, filter)
+}
+
+func (r *cinemaRepositoryWithCache) GetScreeningsByIds(ctx context.Context, ids []int64) ([]models.Screening, error) {
+	return r.repo.GetScreeningsByIds(ctx, ids)
+}
+
 func (r *cinemaRepositoryWithCache) GetCinema(ctx context.Context, id int32) (cinema models.Cinema, err error) {
 	cinema, err = r.cache.GetCinema(ctx, id)
 	if err == nil {
@@ -171,7 +394,7 @@ func (r *cinemaRepositoryWithCache) GetCinema(ctx context.Context, id int32) (ci
 	}
 
 	go func() {
-		err := r.cache.CacheCinema(context.Background(), cinema, r.cacheCfg.CinemasTTL)
+		err := r.cache.CacheCinema(context.WithoutCancel(ctx), cinema, r.cacheCfg.CinemasTTL)
 		if err != nil {
 			r.logger.Errorf("error rhile cinema, %s", err)
 		}
@@ -179,6 +402,39 @@ func (r *cinemaRepositoryWithCache) GetCinema(ctx context.Context, id int32) (ci
 	return
 }
 
+func (r *cinemaRepositoryWithCache) GetCinemas(ctx context.Context,
+	ids []int32) (cinemas []models.Cinema, err error) {
+	r.logger.Info("Searching cinemas in cache")
+	cachedCinemas, notFoundedIDs, err := r.cache.GetCinemas(ctx, ids)
+	if err != nil {
+		r.logger.Error(err)
+		notFoundedIDs = ids
+	}
+
+	if len(notFoundedIDs) == 0 {
+		return cachedCinemas, nil
+	}
+
+	r.logger.Info("Searching cinemas in repository")
+	cinemas, err = r.repo.GetCinemas(ctx, notFoundedIDs)
+	if err != nil {
+		return
+	}
+	if len(cinemas) == 0 {
+		return cachedCinemas, nil
+	}
+
+	toCache := cinemas
+	cinemas = append(cinemas, cachedCinemas...)
+	go func() {
+		err := r.cache.CacheCinemas(context.WithoutCancel(ctx), toCache, r.cacheCfg.CinemasTTL)
+		if err != nil {
+			r.logger.Errorf("error rhile caching cinemas, %s", err)
+		}
+	}()
+	return
+}
+
 func (r *cinemaRepositoryWithCache) GetCinemasCities(ctx context.Context) (cities []models.City, err error) {
 	cities, err = r.cache.GetCinemasCities(ctx)
 	if err == nil {
@@ -191,7 +447,7 @@ func (r *cinemaRepositoryWithCache) GetCinemasCities(ctx context.Context) (citie
 	}
 
 	go func() {
-		err := r.cache.CacheCinemasCities(context.Background(), cities, r.cacheCfg.CitiesTTL)
+		err := r.cache.CacheCinemasCities(context.WithoutCancel(ctx), cities, r.cacheCfg.CitiesTTL)
 		if err != nil {
 			r.logger.Errorf("error rhile caching cinemas cities, %s", err)
 		}
@@ -224,6 +480,29 @@ func (r *cinemaRepositoryWithCache) GetHallConfiguraion(ctx context.Context,
 	return places, nil
 }
 
+func (r *cinemaRepositoryWithCache) GetCinemaHalls(ctx context.Context, cinemaID int32) (halls []models.Hall, err error) {
+	halls, err = r.cache.GetCinemaHalls(ctx, cinemaID)
+	if err == nil {
+		return
+	}
+
+	halls, err = r.repo.GetCinemaHalls(ctx, cinemaID)
+	if err != nil {
+		return
+	}
+	if len(halls) == 0 {
+		return
+	}
+
+	go func() {
+		err := r.cache.CacheCinemaHalls(context.WithoutCancel(ctx), cinemaID, halls, r.cacheCfg.HallsTTL)
+		if err != nil {
+			r.logger.Errorf("error rhile caching cinema halls, %s", err)
+		}
+	}()
+	return
+}
+
 func (r *cinemaRepositoryWithCache) GetHalls(ctx context.Context,
 	ids []int32) (halls []models.Hall, err error) {
 	r.logger.Info("Searching halls in cache")
@@ -244,7 +523,7 @@ func (r *cinemaRepositoryWithCache) GetHalls(ctx context.Context,
 	halls = append(halls, cachedHalls...)
 
 	go func() {
-		err := r.cache.CacheHalls(context.Background(), halls, r.cacheCfg.HallsTTL)
+		err := r.cache.CacheHalls(context.WithoutCancel(ctx), halls, r.cacheCfg.HallsTTL)
 		if err != nil {
 			r.logger.Errorf("error rhile caching halls, %s", err)
 		}
@@ -0,0 +1,60 @@
+package seatavailability
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	cinema_orders_service "github.com/Falokut/cinema_service/pkg/cinema_orders_service/v1/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	// Address of the cinema orders service, if empty seats availability is disabled.
+	Addr    string        `yaml:"addr" env:"SEAT_AVAILABILITY_PROVIDER_ADDR"`
+	Timeout time.Duration `yaml:"timeout" env:"SEAT_AVAILABILITY_PROVIDER_TIMEOUT"`
+}
+
+// GrpcProvider receives occupied places from the cinema orders service.
+type GrpcProvider struct {
+	conn    *grpc.ClientConn
+	client  cinema_orders_service.CinemaOrdersServiceV1Client
+	timeout time.Duration
+}
+
+func NewGrpcProvider(cfg Config) (*GrpcProvider, error) {
+	conn, err := grpc.Dial(cfg.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GrpcProvider{
+		conn:    conn,
+		client:  cinema_orders_service.NewCinemaOrdersServiceV1Client(conn),
+		timeout: cfg.Timeout,
+	}, nil
+}
+
+func (p *GrpcProvider) Close() error {
+	return p.conn.Close()
+}
+
+func (p *GrpcProvider) GetOccupiedPlaces(ctx context.Context, screeningID int64) ([]models.Place, error) {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	res, err := p.client.GetOccupiedPlaces(ctx, &cinema_orders_service.GetOccupiedPlacesRequest{ScreeningID: screeningID})
+	if err != nil {
+		return nil, err
+	}
+
+	places := make([]models.Place, len(res.Places))
+	for i, place := range res.Places {
+		places[i] = models.Place{Row: place.Row, Seat: place.Seat}
+	}
+	return places, nil
+}
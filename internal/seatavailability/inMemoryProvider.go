@@ -0,0 +1,44 @@
+package seatavailability
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+// InMemoryProvider stores occupied places in memory, used in tests and for local development.
+type InMemoryProvider struct {
+	mu       sync.RWMutex
+	occupied map[int64][]models.Place
+	// if not nil, returned by every GetOccupiedPlaces call
+	err error
+}
+
+func NewInMemoryProvider() *InMemoryProvider {
+	return &InMemoryProvider{occupied: make(map[int64][]models.Place)}
+}
+
+func (p *InMemoryProvider) SetOccupiedPlaces(screeningID int64, places []models.Place) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.occupied[screeningID] = slices.Clone(places)
+}
+
+// SetError makes the provider fail, allows to check behavior when the booking service is unavailable.
+func (p *InMemoryProvider) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *InMemoryProvider) GetOccupiedPlaces(_ context.Context, screeningID int64) ([]models.Place, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return slices.Clone(p.occupied[screeningID]), nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const (
+	defaultAlternativesLimit = 10
+	maxAlternativesLimit     = 50
+	// alternatives start not earlier and not later than the screening start time with this offset
+	alternativesTimeWindow = 3 * 24 * time.Hour
+)
+
+func (s *cinemaService) GetAlternatives(ctx context.Context,
+	screeningID int64, limit int, filter models.ScreeningsFilter) ([]models.AlternativeScreening, error) {
+	if limit < 0 || limit > maxAlternativesLimit {
+		return nil, models.Errorf(models.InvalidArgument, "limit must be in range [0, %d]", maxAlternativesLimit)
+	}
+	if limit == 0 {
+		limit = defaultAlternativesLimit
+	}
+
+	screening, err := s.r.GetScreening(ctx, screeningID)
+	if models.Code(err) == models.NotFound {
+		return nil, models.Error(models.NotFound, "screening with specified id not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.r.GetAlternativeScreenings(ctx, screening, alternativesTimeWindow, limit, filter)
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const maxBatchSize = 100
+
+func (s *cinemaService) GetCinemasByIds(ctx context.Context,
+	ids []int32) (cinemas []models.Cinema, missingIDs []int32, err error) {
+	ids, err = uniqueBatchIds(ids)
+	if err != nil {
+		return
+	}
+
+	found, err := s.r.GetCinemas(ctx, ids)
+	if err != nil {
+		return
+	}
+
+	cinemas, missingIDs = orderByIds(ids, found, func(cinema *models.Cinema) int32 { return cinema.ID })
+	return
+}
+
+func (s *cinemaService) GetScreeningsByIds(ctx context.Context, ids []int64,
+	enrich bool) (screenings []models.Screening, missingIDs []int64, err error) {
+	ids, err = uniqueBatchIds(ids)
+	if err != nil {
+		return
+	}
+
+	found, err := s.r.GetScreeningsByIds(ctx, ids)
+	if err != nil {
+		return
+	}
+	if enrich && len(found) > 0 {
+		found = s.enrichScreenings(ctx, found)
+	}
+
+	screenings, missingIDs = orderByIds(ids, found, func(screening *models.Screening) int64 { return screening.ScreeningID })
+	return
+}
+
+// uniqueBatchIds removes duplicates from the ids and checks the batch size.
+func uniqueBatchIds[ID comparable](ids []ID) ([]ID, error) {
+	if len(ids) == 0 {
+		return nil, models.Error(models.InvalidArgument, "ids mustn't be empty")
+	}
+
+	seen := make(map[ID]struct{}, len(ids))
+	unique := make([]ID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	if len(unique) > maxBatchSize {
+		return nil, models.Errorf(models.InvalidArgument, "too many ids, maximum %d ids are allowed", maxBatchSize)
+	}
+	return unique, nil
+}
+
+// orderByIds returns the items in the order of the ids and the ids of the missing items.
+func orderByIds[T any, ID comparable](ids []ID, items []T, idOf func(*T) ID) (ordered []T, missing []ID) {
+	byID := make(map[ID]int, len(items))
+	for i := range items {
+		byID[idOf(&items[i])] = i
+	}
+
+	ordered = make([]T, 0, len(items))
+	missing = make([]ID, 0)
+	for _, id := range ids {
+		i, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, items[i])
+	}
+	return
+}
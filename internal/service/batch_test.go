@@ -0,0 +1,100 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+func TestOrderByIds(t *testing.T) {
+	type item struct {
+		id   int32
+		name string
+	}
+	idOf := func(i *item) int32 { return i.id }
+
+	testCases := []struct {
+		name            string
+		ids             []int32
+		items           []item
+		expectedOrder   []int32
+		expectedMissing []int32
+	}{
+		{
+			name:            "nothing found",
+			ids:             []int32{1, 2},
+			expectedOrder:   []int32{},
+			expectedMissing: []int32{1, 2},
+		},
+		{
+			name:            "items reordered",
+			ids:             []int32{3, 1, 2},
+			items:           []item{{id: 1}, {id: 2}, {id: 3}},
+			expectedOrder:   []int32{3, 1, 2},
+			expectedMissing: []int32{},
+		},
+		{
+			name:            "missing in the ids order",
+			ids:             []int32{5, 1, 4, 2},
+			items:           []item{{id: 2}, {id: 1}},
+			expectedOrder:   []int32{1, 2},
+			expectedMissing: []int32{5, 4},
+		},
+		{
+			name:            "items not requested are dropped",
+			ids:             []int32{2},
+			items:           []item{{id: 1}, {id: 2}},
+			expectedOrder:   []int32{2},
+			expectedMissing: []int32{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ordered, missing := orderByIds(tc.ids, tc.items, idOf)
+			orderedIds := make([]int32, len(ordered))
+			for i := range ordered {
+				orderedIds[i] = ordered[i].id
+			}
+			if !slices.Equal(orderedIds, tc.expectedOrder) {
+				t.Errorf("expected order %v, got %v", tc.expectedOrder, orderedIds)
+			}
+			if !slices.Equal(missing, tc.expectedMissing) {
+				t.Errorf("expected missing %v, got %v", tc.expectedMissing, missing)
+			}
+		})
+	}
+}
+
+func TestUniqueBatchIds(t *testing.T) {
+	tooMany := make([]int64, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i)
+	}
+	maxDuplicated := append(slices.Clone(tooMany[:maxBatchSize]), tooMany[:maxBatchSize]...)
+
+	testCases := []struct {
+		name         string
+		ids          []int64
+		expected     []int64
+		expectedCode models.ErrorCode
+	}{
+		{name: "empty", expectedCode: models.InvalidArgument},
+		{name: "duplicates removed in order", ids: []int64{3, 1, 3, 2, 1}, expected: []int64{3, 1, 2}},
+		{name: "too many ids", ids: tooMany, expectedCode: models.InvalidArgument},
+		{name: "size is checked after removing duplicates", ids: maxDuplicated, expected: tooMany[:maxBatchSize]},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unique, err := uniqueBatchIds(tc.ids)
+			if models.Code(err) != tc.expectedCode {
+				t.Fatalf("expected %s error, got %v", tc.expectedCode, err)
+			}
+			if !slices.Equal(unique, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, unique)
+			}
+		})
+	}
+}
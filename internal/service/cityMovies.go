@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const (
+	defaultNowShowingHorizonDays = 7
+	maxNowShowingHorizonDays     = 60
+
+	defaultUpcomingPerMovieLimit = 5
+	maxUpcomingPerMovieLimit     = 20
+)
+
+func (s *cinemaService) GetNowShowing(ctx context.Context, cityID int32, horizonDays uint32,
+	filter models.ScreeningsFilter) ([]models.CityMovie, error) {
+	horizonDays, err := nowShowingHorizon(horizonDays)
+	if err != nil {
+		return nil, err
+	}
+	return s.r.GetNowShowing(ctx, cityID, horizonDays, filter)
+}
+
+func (s *cinemaService) GetComingSoon(ctx context.Context, cityID int32, horizonDays uint32,
+	filter models.ScreeningsFilter) ([]models.CityMovie, error) {
+	horizonDays, err := nowShowingHorizon(horizonDays)
+	if err != nil {
+		return nil, err
+	}
+	return s.r.GetComingSoon(ctx, cityID, horizonDays, filter)
+}
+
+func nowShowingHorizon(horizonDays uint32) (uint32, error) {
+	if horizonDays > maxNowShowingHorizonDays {
+		return 0, models.Errorf(models.InvalidArgument, "days must be in range [0, %d]", maxNowShowingHorizonDays)
+	}
+	if horizonDays == 0 {
+		return defaultNowShowingHorizonDays, nil
+	}
+	return horizonDays, nil
+}
+
+func (s *cinemaService) GetCinemaUpcoming(ctx context.Context,
+	cinemaID int32, perMovieLimit int, filter models.ScreeningsFilter) ([]models.MovieUpcomingScreenings, error) {
+	if perMovieLimit < 0 || perMovieLimit > maxUpcomingPerMovieLimit {
+		return nil, models.Errorf(models.InvalidArgument, "per_movie_limit must be in range [0, %d]", maxUpcomingPerMovieLimit)
+	}
+	if perMovieLimit == 0 {
+		perMovieLimit = defaultUpcomingPerMovieLimit
+	}
+	return s.r.GetCinemaUpcoming(ctx, cinemaID, perMovieLimit, filter)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+func (s *cinemaService) CreateHallMaintenanceWindow(ctx context.Context,
+	window models.HallMaintenanceWindow) (models.HallMaintenanceWindow, error) {
+	if !window.EndTime.After(window.StartTime) {
+		return models.HallMaintenanceWindow{}, models.Error(models.InvalidArgument, "maintenance end time must be after the start time")
+	}
+	if !window.EndTime.After(time.Now()) {
+		return models.HallMaintenanceWindow{}, models.Error(models.InvalidArgument, "maintenance end time must be in the future")
+	}
+
+	halls, err := s.r.GetHalls(ctx, []int32{window.HallID})
+	if err != nil {
+		return models.HallMaintenanceWindow{}, err
+	}
+	if len(halls) == 0 {
+		return models.HallMaintenanceWindow{}, models.Error(models.NotFound, "hall not found")
+	}
+
+	window.ID, err = s.r.CreateHallMaintenanceWindow(ctx, window)
+	if err != nil {
+		return models.HallMaintenanceWindow{}, err
+	}
+
+	window.AffectedScreeningsIDs, err = s.r.GetHallScreeningsInPeriod(ctx, window.HallID, window.StartTime, window.EndTime)
+	if err != nil {
+		// the window is already created, so only affected screenings are missing
+		s.logger.Errorf("can't get screenings affected by the maintenance window %d: %v", window.ID, err)
+	}
+	return window, nil
+}
+
+func (s *cinemaService) GetHallMaintenanceWindows(ctx context.Context,
+	hallID int32) ([]models.HallMaintenanceWindow, error) {
+	windows, err := s.r.GetHallMaintenanceWindows(ctx, hallID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range windows {
+		windows[i].AffectedScreeningsIDs, err = s.r.GetHallScreeningsInPeriod(ctx, hallID,
+			windows[i].StartTime, windows[i].EndTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return windows, nil
+}
+
+// fillHallsMaintenance sets the current maintenance windows of the halls.
+func (s *cinemaService) fillHallsMaintenance(ctx context.Context, halls []models.Hall) ([]models.Hall, error) {
+	ids := make([]int32, len(halls))
+	for i := range halls {
+		ids[i] = halls[i].ID
+	}
+
+	windows, err := s.r.GetHallsCurrentMaintenance(ctx, ids)
+	if err != nil || len(windows) == 0 {
+		return halls, err
+	}
+
+	for i := range windows {
+		for j := range halls {
+			if halls[j].ID == windows[i].HallID {
+				halls[j].Maintenance = &windows[i]
+			}
+		}
+	}
+	return halls, nil
+}
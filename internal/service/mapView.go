@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const (
+	maxMapZoom = 22
+	// starting from this zoom the cinemas are returned without clustering
+	clusteringMaxZoom = 12
+	// number of the clusters grid cells per the map tile side
+	clustersPerTile = 4
+)
+
+func (s *cinemaService) GetCinemasInBounds(ctx context.Context,
+	bounds models.GeoBounds, zoom uint32, filter models.CinemasFilter) (res models.CinemasInBounds, err error) {
+	if !bounds.IsValid() {
+		return res, models.Error(models.InvalidArgument, "invalid bounds, latitude must be in range [-90, 90],"+
+			" longitude must be in range [-180, 180] and min values mustn't be greater than max values")
+	}
+	if zoom > maxMapZoom {
+		return res, models.Errorf(models.InvalidArgument, "zoom must be in range [0, %d]", maxMapZoom)
+	}
+
+	if zoom >= clusteringMaxZoom {
+		res.Cinemas, err = s.r.GetCinemasInBounds(ctx, bounds, filter)
+		return
+	}
+
+	res.Clusters, err = s.r.GetCinemasClustersInBounds(ctx, bounds, clustersGridSize(zoom), filter)
+	return
+}
+
+// clustersGridSize returns the grid cell size in degrees, the map tile at the zoom covers 360/2^zoom degrees.
+func clustersGridSize(zoom uint32) float64 {
+	return 360 / math.Exp2(float64(zoom)) / clustersPerTile
+}
+
+func (s *cinemaService) ResolveCity(ctx context.Context, point models.GeoPoint) (models.ResolvedCity, error) {
+	if point.Latityde < -90 || point.Latityde > 90 || point.Longitude < -180 || point.Longitude > 180 {
+		return models.ResolvedCity{}, models.Error(models.InvalidArgument,
+			"invalid coordinates, latitude must be in range [-90, 90], longitude must be in range [-180, 180]")
+	}
+
+	city, err := s.r.ResolveCity(ctx, point)
+	if models.Code(err) == models.NotFound {
+		return city, models.Error(models.NotFound, "city not found, there are no cities with cinemas")
+	}
+	return city, err
+}
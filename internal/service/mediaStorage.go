@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+// MediaStorage stores uploaded media files.
+type MediaStorage interface {
+	// Saves the file with the specified name and returns its public url.
+	SaveFile(ctx context.Context, name string, data []byte) (string, error)
+	DeleteFile(ctx context.Context, name string) error
+}
+
+var photosExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+func (s *cinemaService) UploadCinemaPhoto(ctx context.Context, cinemaID int32, photo []byte) (string, error) {
+	if len(photo) == 0 {
+		return "", models.Error(models.InvalidArgument, "photo mustn't be empty")
+	}
+	ext, ok := photosExtensions[http.DetectContentType(photo)]
+	if !ok {
+		return "", models.Error(models.InvalidArgument, "unsupported photo format, supported formats: jpeg, png, webp")
+	}
+
+	if _, err := s.r.GetCinema(ctx, cinemaID); err != nil {
+		return "", err
+	}
+
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("cinemas/%d/%s%s", cinemaID, hex.EncodeToString(suffix), ext)
+
+	url, err := s.mediaStorage.SaveFile(ctx, name, photo)
+	if err != nil {
+		s.logger.Errorf("can't save cinema photo: %v", err)
+		return "", models.Error(models.Internal, "can't save photo")
+	}
+
+	if _, err = s.r.AddCinemaPhoto(ctx, cinemaID, url); err != nil {
+		if derr := s.mediaStorage.DeleteFile(ctx, name); derr != nil {
+			s.logger.Errorf("can't delete not saved cinema photo %s: %v", name, derr)
+		}
+		return "", err
+	}
+
+	return url, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+type MovieCatalog interface {
+	// Returns the movies with specified ids, not found movies are not included.
+	GetMovies(ctx context.Context, ids []int32) (map[int32]models.Movie, error)
+}
+
+// getMovies returns the movies from the catalog, if the catalog is not configured or unavailable, returns nil.
+func (s *cinemaService) getMovies(ctx context.Context, ids []int32) map[int32]models.Movie {
+	if s.movieCatalog == nil || len(ids) == 0 {
+		return nil
+	}
+
+	movies, err := s.movieCatalog.GetMovies(ctx, ids)
+	if err != nil {
+		s.logger.Warnf("can't get movies from the movie catalog, screenings won't be enriched: %v", err)
+		return nil
+	}
+	return movies
+}
+
+// enrichScreenings sets the movies titles and the end times, if the end time is not stored,
+// it's calculated from the movie runtime.
+func (s *cinemaService) enrichScreenings(ctx context.Context, screenings []models.Screening) []models.Screening {
+	ids := make([]int32, 0, len(screenings))
+	for i := range screenings {
+		if !slices.Contains(ids, screenings[i].MovieID) {
+			ids = append(ids, screenings[i].MovieID)
+		}
+	}
+
+	movies := s.getMovies(ctx, ids)
+	if len(movies) == 0 {
+		return screenings
+	}
+
+	for i := range screenings {
+		movie, ok := movies[screenings[i].MovieID]
+		if !ok {
+			continue
+		}
+		screenings[i].MovieTitle = movie.Title
+		screenings[i].EndTime = screeningEndTime(screenings[i].StartTime, screenings[i].EndTime, movie)
+	}
+	return screenings
+}
+
+func (s *cinemaService) enrichCityScreenings(ctx context.Context,
+	movieID int32, screenings []models.CityScreening) []models.CityScreening {
+	movie, ok := s.getMovies(ctx, []int32{movieID})[movieID]
+	if !ok {
+		return screenings
+	}
+
+	for i := range screenings {
+		screenings[i].MovieTitle = movie.Title
+		screenings[i].EndTime = screeningEndTime(screenings[i].StartTime, screenings[i].EndTime, movie)
+	}
+	return screenings
+}
+
+func screeningEndTime(start time.Time, end *time.Time, movie models.Movie) *time.Time {
+	if end != nil || movie.Runtime <= 0 {
+		return end
+	}
+	calculated := start.Add(movie.Runtime)
+	return &calculated
+}
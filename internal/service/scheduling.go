@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+func (s *cinemaService) CreateScreening(ctx context.Context, screening models.NewScreening) (int64, error) {
+	if !screening.StartTime.After(time.Now()) {
+		return 0, models.Error(models.InvalidArgument, "screening start time must be in the future")
+	}
+	if err := s.fillScreeningFromCatalog(ctx, &screening); err != nil {
+		return 0, err
+	}
+	if !screening.EndTime.After(screening.StartTime) {
+		return 0, models.Error(models.InvalidArgument, "screening end time must be after the start time")
+	}
+
+	if err := s.checkScreeningSchedule(ctx, screening.HallID, screening.StartTime, screening.EndTime); err != nil {
+		return 0, err
+	}
+
+	return s.r.CreateScreening(ctx, screening)
+}
+
+// checkScreeningSchedule checks that the screening in the hall can be scheduled for the specified period.
+func (s *cinemaService) checkScreeningSchedule(ctx context.Context, hallID int32, start, end time.Time) error {
+	halls, err := s.r.GetHalls(ctx, []int32{hallID})
+	if err != nil {
+		return err
+	}
+	if len(halls) == 0 {
+		return models.Error(models.NotFound, "hall not found")
+	}
+	// the hall cinema is deleted
+	if halls[0].CinemaID == 0 {
+		return models.Error(models.NotFound, "hall cinema not found")
+	}
+
+	cinema, err := s.r.GetCinema(ctx, halls[0].CinemaID)
+	if err != nil {
+		return err
+	}
+	if !cinema.IsOpenDuring(start, end) {
+		return models.Error(models.InvalidArgument, "screening is outside the cinema opening hours")
+	}
+
+	windows, err := s.r.GetHallMaintenanceWindows(ctx, hallID)
+	if err != nil {
+		return err
+	}
+	for i := range windows {
+		if windows[i].Intersects(start, end) {
+			return models.Errorf(models.Conflict, "hall is under maintenance from %s to %s",
+				windows[i].StartTime.Format(time.RFC3339), windows[i].EndTime.Format(time.RFC3339))
+		}
+	}
+
+	overlapping, err := s.r.GetHallScreeningsInPeriod(ctx, hallID, start, end)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return models.Errorf(models.Conflict, "screening overlaps the screening with id %d in the hall", overlapping[0])
+	}
+
+	return nil
+}
+
+// fillScreeningFromCatalog sets the end time and the age rating of the screening from the movie catalog, if they are not specified.
+func (s *cinemaService) fillScreeningFromCatalog(ctx context.Context, screening *models.NewScreening) error {
+	if !screening.EndTime.IsZero() && screening.AgeRating != "" {
+		return nil
+	}
+
+	movie, ok := s.getMovies(ctx, []int32{screening.MovieID})[screening.MovieID]
+	if screening.AgeRating == "" && ok {
+		screening.AgeRating = movie.AgeRating
+	}
+	if !screening.EndTime.IsZero() {
+		return nil
+	}
+	if !ok || movie.Runtime <= 0 {
+		return models.Error(models.InvalidArgument,
+			"duration must be greater than zero, the movie runtime is unknown")
+	}
+
+	screening.EndTime = screening.StartTime.Add(movie.Runtime)
+	return nil
+}
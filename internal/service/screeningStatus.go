@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+// allowed screening status transitions, cancelled and moved screenings can't be changed.
+var screeningStatusTransitions = map[models.ScreeningStatus][]models.ScreeningStatus{
+	models.ScreeningStatusScheduled: {
+		models.ScreeningStatusCancelled,
+		models.ScreeningStatusPostponed,
+		models.ScreeningStatusMoved,
+		models.ScreeningStatusSoldOut,
+	},
+	models.ScreeningStatusSoldOut: {
+		models.ScreeningStatusScheduled,
+		models.ScreeningStatusCancelled,
+		models.ScreeningStatusPostponed,
+		models.ScreeningStatusMoved,
+	},
+	models.ScreeningStatusPostponed: {
+		models.ScreeningStatusScheduled,
+		models.ScreeningStatusCancelled,
+		models.ScreeningStatusMoved,
+	},
+}
+
+func canChangeScreeningStatus(from, to models.ScreeningStatus) bool {
+	for _, status := range screeningStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *cinemaService) UpdateScreeningStatus(ctx context.Context, change models.ScreeningStatusChange) error {
+	screening, err := s.r.GetScreening(ctx, change.ScreeningID)
+	if err != nil {
+		return err
+	}
+
+	// the status of the started screening can be changed only until the end of the tickets sales
+	if !time.Now().Before(screening.SalesCloseAt) {
+		return models.Error(models.InvalidArgument, "screening status can't be changed after the tickets sales close")
+	}
+	if !canChangeScreeningStatus(screening.Status, change.Status) {
+		return models.Errorf(models.InvalidArgument, "screening status can't be changed from %s to %s",
+			screening.Status, change.Status)
+	}
+	change.PreviousStatus = screening.Status
+
+	switch change.Status {
+	case models.ScreeningStatusMoved, models.ScreeningStatusPostponed:
+		if change.ReplacementScreeningID == nil {
+			if change.Status == models.ScreeningStatusMoved {
+				return models.Error(models.InvalidArgument, "replacement screening must be specified for the moved screening")
+			}
+			break
+		}
+		if *change.ReplacementScreeningID == change.ScreeningID {
+			return models.Error(models.InvalidArgument, "screening can't replace itself")
+		}
+		if _, err = s.r.GetScreening(ctx, *change.ReplacementScreeningID); err != nil {
+			if models.Code(err) == models.NotFound {
+				return models.Error(models.InvalidArgument, "replacement screening not found")
+			}
+			return err
+		}
+	default:
+		// replacement screening makes sense only for the moved and postponed screenings
+		change.ReplacementScreeningID = nil
+	}
+
+	return s.r.UpdateScreeningStatus(ctx, change)
+}
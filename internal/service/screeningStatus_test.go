@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	"github.com/Falokut/cinema_service/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+func TestCanChangeScreeningStatus(t *testing.T) {
+	statuses := []models.ScreeningStatus{
+		models.ScreeningStatusScheduled,
+		models.ScreeningStatusCancelled,
+		models.ScreeningStatusPostponed,
+		models.ScreeningStatusMoved,
+		models.ScreeningStatusSoldOut,
+	}
+	allowed := map[models.ScreeningStatus][]models.ScreeningStatus{
+		models.ScreeningStatusScheduled: {models.ScreeningStatusCancelled, models.ScreeningStatusPostponed,
+			models.ScreeningStatusMoved, models.ScreeningStatusSoldOut},
+		models.ScreeningStatusSoldOut: {models.ScreeningStatusScheduled, models.ScreeningStatusCancelled,
+			models.ScreeningStatusPostponed, models.ScreeningStatusMoved},
+		models.ScreeningStatusPostponed: {models.ScreeningStatusScheduled, models.ScreeningStatusCancelled,
+			models.ScreeningStatusMoved},
+		// cancelled and moved screenings are final
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			expected := false
+			for _, status := range allowed[from] {
+				expected = expected || status == to
+			}
+			if can := canChangeScreeningStatus(from, to); can != expected {
+				t.Errorf("%s -> %s: expected %t, got %t", from, to, expected, can)
+			}
+		}
+	}
+}
+
+// screeningsRepositoryStub stores the screenings in memory and records the status changes.
+type screeningsRepositoryStub struct {
+	repository.CinemaRepository
+	screenings map[int64]models.Screening
+	changes    []models.ScreeningStatusChange
+}
+
+func (r *screeningsRepositoryStub) GetScreening(_ context.Context, id int64) (models.Screening, error) {
+	screening, ok := r.screenings[id]
+	if !ok {
+		return models.Screening{}, models.Error(models.NotFound, "screening not found")
+	}
+	return screening, nil
+}
+
+func (r *screeningsRepositoryStub) UpdateScreeningStatus(_ context.Context, change models.ScreeningStatusChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func TestUpdateScreeningStatus(t *testing.T) {
+	salesOpened := time.Now().Add(time.Hour)
+	salesClosed := time.Now().Add(-time.Minute)
+	screening := func(id int64, status models.ScreeningStatus, salesCloseAt time.Time) models.Screening {
+		s := models.Screening{ScreeningID: id}
+		s.Status = status
+		s.SalesCloseAt = salesCloseAt
+		return s
+	}
+	replacementID := int64(3)
+	selfID := int64(1)
+	unknownID := int64(100)
+
+	testCases := []struct {
+		name         string
+		change       models.ScreeningStatusChange
+		expectedCode models.ErrorCode
+		expected     models.ScreeningStatusChange
+	}{
+		{
+			name: "cancelled with the previous status",
+			change: models.ScreeningStatusChange{ScreeningID: 1, Status: models.ScreeningStatusCancelled,
+				ReplacementScreeningID: &replacementID},
+			expected: models.ScreeningStatusChange{ScreeningID: 1, PreviousStatus: models.ScreeningStatusScheduled,
+				Status: models.ScreeningStatusCancelled},
+		},
+		{
+			name: "moved to the replacement",
+			change: models.ScreeningStatusChange{ScreeningID: 1, Status: models.ScreeningStatusMoved,
+				ReplacementScreeningID: &replacementID},
+			expected: models.ScreeningStatusChange{ScreeningID: 1, PreviousStatus: models.ScreeningStatusScheduled,
+				Status: models.ScreeningStatusMoved, ReplacementScreeningID: &replacementID},
+		},
+		{
+			name:         "moved without replacement",
+			change:       models.ScreeningStatusChange{ScreeningID: 1, Status: models.ScreeningStatusMoved},
+			expectedCode: models.InvalidArgument,
+		},
+		{
+			name: "replaced by itself",
+			change: models.ScreeningStatusChange{ScreeningID: 1, Status: models.ScreeningStatusPostponed,
+				ReplacementScreeningID: &selfID},
+			expectedCode: models.InvalidArgument,
+		},
+		{
+			name: "replacement not found",
+			change: models.ScreeningStatusChange{ScreeningID: 1, Status: models.ScreeningStatusMoved,
+				ReplacementScreeningID: &unknownID},
+			expectedCode: models.InvalidArgument,
+		},
+		{
+			name:         "final status",
+			change:       models.ScreeningStatusChange{ScreeningID: 2, Status: models.ScreeningStatusScheduled},
+			expectedCode: models.InvalidArgument,
+		},
+		{
+			name:         "after the tickets sales close",
+			change:       models.ScreeningStatusChange{ScreeningID: 4, Status: models.ScreeningStatusCancelled},
+			expectedCode: models.InvalidArgument,
+		},
+		{
+			name:         "screening not found",
+			change:       models.ScreeningStatusChange{ScreeningID: unknownID, Status: models.ScreeningStatusCancelled},
+			expectedCode: models.NotFound,
+		},
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &screeningsRepositoryStub{screenings: map[int64]models.Screening{
+				1: screening(1, models.ScreeningStatusScheduled, salesOpened),
+				2: screening(2, models.ScreeningStatusCancelled, salesOpened),
+				3: screening(3, models.ScreeningStatusScheduled, salesOpened),
+				4: screening(4, models.ScreeningStatusScheduled, salesClosed),
+			}}
+			s := NewCinemaService(logger, repo, nil, nil, nil)
+
+			err := s.UpdateScreeningStatus(context.Background(), tc.change)
+			if models.Code(err) != tc.expectedCode {
+				t.Fatalf("expected %s error, got %v", tc.expectedCode, err)
+			}
+			if tc.expectedCode != models.Unknown {
+				if len(repo.changes) != 0 {
+					t.Errorf("expected no status changes, got %v", repo.changes)
+				}
+				return
+			}
+
+			if len(repo.changes) != 1 {
+				t.Fatalf("expected one status change, got %d", len(repo.changes))
+			}
+			change := repo.changes[0]
+			if change.ScreeningID != tc.expected.ScreeningID ||
+				change.PreviousStatus != tc.expected.PreviousStatus ||
+				change.Status != tc.expected.Status ||
+				(change.ReplacementScreeningID == nil) != (tc.expected.ReplacementScreeningID == nil) {
+				t.Errorf("expected change %+v, got %+v", tc.expected, change)
+			}
+		})
+	}
+}
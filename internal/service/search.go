@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// limit for the cities autocomplete
+	defaultAutocompleteLimit = 10
+	// minimal length of the common prefix of the words to highlight the word
+	minHighlightPrefixLen = 4
+)
+
+func (s *cinemaService) Search(ctx context.Context, query string, cityID *int32, limit int) ([]models.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, models.Error(models.InvalidArgument, "query mustn't be empty")
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, models.Errorf(models.InvalidArgument, "limit must be in range [0, %d]", maxSearchLimit)
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	results, err := s.r.Search(ctx, query, cityID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	queryWords := strings.FieldsFunc(transliterate(query), isNotWordRune)
+	for i := range results {
+		results[i].NameHighlight = highlight(results[i].Name, queryWords)
+		results[i].AddressHighlight = highlight(results[i].Address, queryWords)
+	}
+	return results, nil
+}
+
+func (s *cinemaService) AutocompleteCities(ctx context.Context, prefix string, limit int) ([]models.CitySuggestion, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, models.Error(models.InvalidArgument, "prefix mustn't be empty")
+	}
+	if limit < 0 || limit > maxSearchLimit {
+		return nil, models.Errorf(models.InvalidArgument, "limit must be in range [0, %d]", maxSearchLimit)
+	}
+	if limit == 0 {
+		limit = defaultAutocompleteLimit
+	}
+
+	return s.r.AutocompleteCities(ctx, prefix, limit)
+}
+
+// highlight wraps the words of the text, that match any of the transliterated query words, into <em></em>.
+func highlight(text string, queryWords []string) string {
+	if text == "" {
+		return text
+	}
+
+	var sb strings.Builder
+	runes := []rune(text)
+	for start := 0; start < len(runes); {
+		end := start
+		for end < len(runes) && !isNotWordRune(runes[end]) {
+			end++
+		}
+		if end == start {
+			sb.WriteRune(runes[start])
+			start++
+			continue
+		}
+
+		word := string(runes[start:end])
+		if matchWord(transliterate(word), queryWords) {
+			sb.WriteString("<em>" + word + "</em>")
+		} else {
+			sb.WriteString(word)
+		}
+		start = end
+	}
+	return sb.String()
+}
+
+func matchWord(word string, queryWords []string) bool {
+	for _, q := range queryWords {
+		if strings.HasPrefix(word, q) || strings.HasPrefix(q, word) {
+			return true
+		}
+		if commonPrefixLen(word, q) >= minHighlightPrefixLen {
+			return true
+		}
+	}
+	return false
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+}
+
+// transliterate works the same way as transliterate function in the database.
+func transliterate(str string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(str) {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			sb.WriteString(latin)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// sqlTransliterate evaluates the transliterate function from the database schema,
+// the replace and translate arguments are parsed from the function definition.
+func sqlTransliterate(t *testing.T) func(string) string {
+	t.Helper()
+	schema, err := os.ReadFile("../../cinema_db/db/up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definition := regexp.MustCompile(`(?s)CREATE OR REPLACE FUNCTION transliterate\(str TEXT\).*?\$\$(.*?)\$\$`).
+		FindSubmatch(schema)
+	if definition == nil {
+		t.Fatal("transliterate function not found in the schema")
+	}
+	body := string(definition[1])
+
+	replaces := regexp.MustCompile(`'([^']*)','([^']*)'\)`).FindAllStringSubmatch(body, -1)
+	translate := regexp.MustCompile(`'([^']*)',\s*'([^']*)'\);`).FindStringSubmatch(body)
+	if len(replaces) == 0 || translate == nil {
+		t.Fatal("can't parse transliterate function")
+	}
+	from, to := []rune(translate[1]), []rune(translate[2])
+
+	return func(str string) string {
+		str = strings.ToLower(str)
+		for _, r := range replaces {
+			str = strings.ReplaceAll(str, r[1], r[2])
+		}
+		// characters without the pair in the to string are removed, as translate does
+		return strings.Map(func(r rune) rune {
+			for i := range from {
+				if from[i] != r {
+					continue
+				}
+				if i < len(to) {
+					return to[i]
+				}
+				return -1
+			}
+			return r
+		}, str)
+	}
+}
+
+func TestTransliterateMatchesDatabaseFunction(t *testing.T) {
+	dbTransliterate := sqlTransliterate(t)
+
+	inputs := []string{
+		"",
+		"абвгдеёжзийклмнопрстуфхцчшщъыьэюя",
+		"АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ",
+		"Москва",
+		"Щёлково",
+		"Нижний Новгород",
+		"Кинотеатр «Октябрь», ул. Новый Арбат, 24",
+		"Подъезд",
+		"Cinema Park IMAX",
+		"Mixed Кино 3D",
+	}
+	for _, input := range inputs {
+		if got, expected := transliterate(input), dbTransliterate(input); got != expected {
+			t.Errorf("transliterate(%q): expected %q as in the database, got %q", input, expected, got)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		query    string
+		expected string
+	}{
+		{name: "empty text", text: "", query: "kino", expected: ""},
+		{name: "no match", text: "Cinema Park", query: "mall", expected: "Cinema Park"},
+		{name: "whole word", text: "Cinema Park", query: "park", expected: "Cinema <em>Park</em>"},
+		{name: "word prefix", text: "Cinema Park", query: "cin", expected: "<em>Cinema</em> Park"},
+		{name: "cyrillic text latin query", text: "Кинотеатр Октябрь", query: "oktyabr", expected: "Кинотеатр <em>Октябрь</em>"},
+		{name: "latin text cyrillic query", text: "Oktyabr", query: "октябрь", expected: "<em>Oktyabr</em>"},
+		{name: "common prefix with a typo", text: "Кинотеатр", query: "kinoteart", expected: "<em>Кинотеатр</em>"},
+		{name: "short common prefix", text: "Kinomax", query: "kiev", expected: "Kinomax"},
+		{name: "several query words", text: "Cinema Park Mall", query: "park mall", expected: "Cinema <em>Park</em> <em>Mall</em>"},
+		{name: "punctuation kept", text: "ул. Арбат, 24", query: "arbat 24", expected: "ул. <em>Арбат</em>, <em>24</em>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			queryWords := strings.FieldsFunc(transliterate(tc.query), isNotWordRune)
+			if highlighted := highlight(tc.text, queryWords); highlighted != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, highlighted)
+			}
+		})
+	}
+}
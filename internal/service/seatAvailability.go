@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Falokut/cinema_service/internal/models"
+)
+
+type SeatAvailabilityProvider interface {
+	// Returns the places that are already occupied for the screening.
+	GetOccupiedPlaces(ctx context.Context, screeningID int64) ([]models.Place, error)
+}
+
+type placeKey struct {
+	row, seat int32
+}
+
+// mergeAvailability marks the places as free or occupied and returns the number of free places.
+func mergeAvailability(places, occupied []models.Place) (free uint32) {
+	occupiedPlaces := make(map[placeKey]struct{}, len(occupied))
+	for i := range occupied {
+		occupiedPlaces[placeKey{row: occupied[i].Row, seat: occupied[i].Seat}] = struct{}{}
+	}
+
+	for i := range places {
+		if _, ok := occupiedPlaces[placeKey{row: places[i].Row, seat: places[i].Seat}]; ok {
+			places[i].Availability = models.PlaceAvailabilityOccupied
+			continue
+		}
+		places[i].Availability = models.PlaceAvailabilityFree
+		free++
+	}
+	return
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/Falokut/cinema_service/internal/models"
+	"github.com/Falokut/cinema_service/internal/repository"
+	"github.com/Falokut/cinema_service/internal/seatavailability"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMergeAvailability(t *testing.T) {
+	testCases := []struct {
+		name     string
+		places   []models.Place
+		occupied []models.Place
+		expected []models.PlaceAvailability
+		free     uint32
+	}{
+		{
+			name:     "no places",
+			expected: []models.PlaceAvailability{},
+		},
+		{
+			name:     "all free",
+			places:   []models.Place{{Row: 1, Seat: 1}, {Row: 1, Seat: 2}},
+			expected: []models.PlaceAvailability{models.PlaceAvailabilityFree, models.PlaceAvailabilityFree},
+			free:     2,
+		},
+		{
+			name:     "partially occupied",
+			places:   []models.Place{{Row: 1, Seat: 1}, {Row: 1, Seat: 2}, {Row: 2, Seat: 1}},
+			occupied: []models.Place{{Row: 1, Seat: 2}},
+			expected: []models.PlaceAvailability{models.PlaceAvailabilityFree,
+				models.PlaceAvailabilityOccupied, models.PlaceAvailabilityFree},
+			free: 2,
+		},
+		{
+			name:     "row and seat are not swapped",
+			places:   []models.Place{{Row: 1, Seat: 2}, {Row: 2, Seat: 1}},
+			occupied: []models.Place{{Row: 2, Seat: 1}},
+			expected: []models.PlaceAvailability{models.PlaceAvailabilityFree, models.PlaceAvailabilityOccupied},
+			free:     1,
+		},
+		{
+			name:     "occupied place not in the hall",
+			places:   []models.Place{{Row: 1, Seat: 1}},
+			occupied: []models.Place{{Row: 5, Seat: 5}},
+			expected: []models.PlaceAvailability{models.PlaceAvailabilityFree},
+			free:     1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			free := mergeAvailability(tc.places, tc.occupied)
+			if free != tc.free {
+				t.Errorf("expected %d free places, got %d", tc.free, free)
+			}
+			for i := range tc.places {
+				if tc.places[i].Availability != tc.expected[i] {
+					t.Errorf("place %d: expected availability %d, got %d", i, tc.expected[i], tc.places[i].Availability)
+				}
+			}
+		})
+	}
+}
+
+// hallRepositoryStub returns the same hall configuration and screening for any id.
+type hallRepositoryStub struct {
+	repository.CinemaRepository
+	places    []models.Place
+	screening models.Screening
+}
+
+func (r *hallRepositoryStub) GetHallConfiguraion(_ context.Context, _ int32) ([]models.Place, error) {
+	places := make([]models.Place, len(r.places))
+	copy(places, r.places)
+	return places, nil
+}
+
+func (r *hallRepositoryStub) GetScreening(_ context.Context, _ int64) (models.Screening, error) {
+	return r.screening, nil
+}
+
+func TestGetHallConfigurationWithAvailability(t *testing.T) {
+	const (
+		hallID      = 1
+		screeningID = 10
+	)
+	places := []models.Place{{Row: 1, Seat: 1}, {Row: 1, Seat: 2}}
+
+	testCases := []struct {
+		name          string
+		screeningHall int32
+		providerErr   error
+		expectedCode  models.ErrorCode
+		expected      []models.PlaceAvailability
+		// nil if availability is unknown
+		free *uint32
+	}{
+		{
+			name:          "availability merged",
+			screeningHall: hallID,
+			expected:      []models.PlaceAvailability{models.PlaceAvailabilityFree, models.PlaceAvailabilityOccupied},
+			free:          func() *uint32 { free := uint32(1); return &free }(),
+		},
+		{
+			name:          "provider failure falls back to unknown availability",
+			screeningHall: hallID,
+			providerErr:   errors.New("orders service is unavailable"),
+			expected:      []models.PlaceAvailability{models.PlaceAvailabilityUnknown, models.PlaceAvailabilityUnknown},
+		},
+		{
+			name:          "screening in another hall",
+			screeningHall: hallID + 1,
+			expectedCode:  models.InvalidArgument,
+		},
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := seatavailability.NewInMemoryProvider()
+			provider.SetOccupiedPlaces(screeningID, []models.Place{{Row: 1, Seat: 2}})
+			if tc.providerErr != nil {
+				provider.SetError(tc.providerErr)
+			}
+			repo := &hallRepositoryStub{
+				places:    places,
+				screening: models.Screening{ScreeningID: screeningID, HallID: tc.screeningHall},
+			}
+			s := NewCinemaService(logger, repo, provider, nil, nil)
+
+			configuration, err := s.GetHallConfigurationWithAvailability(context.Background(), hallID, screeningID)
+			if tc.expectedCode != models.Unknown {
+				if models.Code(err) != tc.expectedCode {
+					t.Fatalf("expected %s error, got %v", tc.expectedCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (configuration.FreePlaces == nil) != (tc.free == nil) ||
+				tc.free != nil && *configuration.FreePlaces != *tc.free {
+				t.Errorf("expected free places %v, got %v", tc.free, configuration.FreePlaces)
+			}
+			if configuration.TotalPlaces != uint32(len(places)) {
+				t.Errorf("expected %d total places, got %d", len(places), configuration.TotalPlaces)
+			}
+			for i := range configuration.Places {
+				if configuration.Places[i].Availability != tc.expected[i] {
+					t.Errorf("place %d: expected availability %d, got %d",
+						i, tc.expected[i], configuration.Places[i].Availability)
+				}
+			}
+		})
+	}
+}
@@ -2,101 +2,328 @@ package service
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/Falokut/cinema_service/internal/models"
 	"github.com/Falokut/cinema_service/internal/repository"
+	"github.com/sirupsen/logrus"
 )
 
 type CinemaService interface {
-	GetScreening(ctx context.Context, id int64) (models.Screening, error)
-	// Returns cinemas in the city.
-	GetCinemasInCity(ctx context.Context, id int32) ([]models.Cinema, error)
+	// If enrich is true, the movie title and the end time are received from the movie catalog.
+	GetScreening(ctx context.Context, id int64, enrich bool) (models.Screening, error)
+	// Returns cinemas in the city, that satisfy the filter.
+	GetCinemasInCity(ctx context.Context, id int32, filter models.CinemasFilter) ([]models.Cinema, error)
 
 	// Returns all cities rhere there are cinemas.
 	GetCinemasCities(ctx context.Context) ([]models.City, error)
 
 	// Returns all movies that are in the cinema screenings in a particular cinema.
-	GetMoviesScreenings(ctx context.Context, cinemaID int32, startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error)
+	GetMoviesScreenings(ctx context.Context, cinemaID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter) ([]models.MoviesScreenings, error)
 
 	// Returns all screenings for a movie in a specific city.
-	GetCityScreenings(ctx context.Context, cityID, movieID int32, startPeriod, endPeriod time.Time) ([]models.CityScreening, error)
+	// If enrich is true, the movie title and the end time are received from the movie catalog.
+	GetCityScreenings(ctx context.Context, cityID, movieID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter, enrich bool) ([]models.CityScreening, error)
 
-	// Returns all movies that are in the cinema screenings in particular cities.
-	GetMoviesScreeningsInCities(ctx context.Context, citiesIDs []int32, startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error)
+	// Returns all movies that are in the cinema screenings in particular cities and cities of the regions.
+	// The stats are aggregated only if includeStats is true.
+	GetMoviesScreeningsInCities(ctx context.Context, citiesIDs, regionsIDs []int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter, includeStats bool) ([]models.MoviesScreenings, error)
 
 	// Returns all screenings for a movie in a specific cinema.
-	GetScreenings(ctx context.Context, cinemaID, movieID int32, startPeriod, endPeriod time.Time) ([]models.Screening, error)
+	// If enrich is true, the movie title and the end time are received from the movie catalog.
+	GetScreenings(ctx context.Context, cinemaID, movieID int32, startPeriod, endPeriod time.Time,
+		filter models.ScreeningsFilter, enrich bool) ([]models.Screening, error)
 
 	// Returns the configuration of the hall.
-	GetHallConfiguraion(ctx context.Context, id int32) ([]models.Place, error)
+	GetHallConfiguraion(ctx context.Context, id int32) (models.HallConfiguration, error)
 
-	// Returns info for the halls rith specified ids (rithout configuration).
+	// Returns the configuration of the hall with places availability for the screening.
+	// If availability can't be received, returns the configuration with unknown availability.
+	GetHallConfigurationWithAvailability(ctx context.Context, hallID int32, screeningID int64) (models.HallConfiguration, error)
+
+	// Returns info for the halls rith specified ids (rithout configuration) with the current maintenance.
 	GetHalls(ctx context.Context, ids []int32) ([]models.Hall, error)
 
+	// Returns all halls of the cinema (without configuration) with the current maintenance.
+	GetCinemaHalls(ctx context.Context, cinemaID int32) ([]models.Hall, error)
+
 	// Returns cinema rith specified id.
 	GetCinema(ctx context.Context, id int32) (models.Cinema, error)
+
+	// Changes the screening status, returns InvalidArgument error if the transition is not allowed
+	// and Conflict error if the status was changed concurrently.
+	UpdateScreeningStatus(ctx context.Context, change models.ScreeningStatusChange) error
+
+	// Returns not finished events with screenings in the city.
+	ListEvents(ctx context.Context, cityID int32) ([]models.Event, error)
+
+	// Returns screenings of the event, returns NotFound error if event not found.
+	GetEventScreenings(ctx context.Context, eventID int32, filter models.ScreeningsFilter) ([]models.Screening, error)
+
+	// Schedules the screening, returns InvalidArgument error if the screening is outside the cinema opening hours
+	// and Conflict error if the hall is under maintenance.
+	CreateScreening(ctx context.Context, screening models.NewScreening) (int64, error)
+
+	// Creates the hall maintenance window, returned window contains screenings that need a reschedule.
+	CreateHallMaintenanceWindow(ctx context.Context, window models.HallMaintenanceWindow) (models.HallMaintenanceWindow, error)
+
+	// Returns current and upcoming maintenance windows of the hall with affected screenings.
+	GetHallMaintenanceWindows(ctx context.Context, hallID int32) ([]models.HallMaintenanceWindow, error)
+
+	// Saves the cinema photo into the media storage and returns its url.
+	UploadCinemaPhoto(ctx context.Context, cinemaID int32, photo []byte) (string, error)
+
+	// Returns chains, that have cinemas in the city.
+	ListChains(ctx context.Context, cityID int32) ([]models.Chain, error)
+
+	// Returns cities and cinemas matching the query, tolerates typos and mixed scripts.
+	// If limit is 0, the default limit is used.
+	Search(ctx context.Context, query string, cityID *int32, limit int) ([]models.SearchResult, error)
+
+	// Returns cities, which name starts with the prefix, the prefix may be in latin or cyrillic.
+	// If limit is 0, the default limit is used.
+	AutocompleteCities(ctx context.Context, prefix string, limit int) ([]models.CitySuggestion, error)
+
+	// Returns cinemas in the bounds, or the cinemas clusters if the zoom is less than the clustering zoom.
+	// The filter OpenAt is not applied.
+	GetCinemasInBounds(ctx context.Context, bounds models.GeoBounds, zoom uint32,
+		filter models.CinemasFilter) (models.CinemasInBounds, error)
+
+	// Streams the cinemas matching the filter into fn, geometry is in the specified format.
+	ExportCinemas(ctx context.Context, filter models.CinemasExportFilter,
+		format models.GeoFormat, fn func(models.CinemaFeature) error) error
+
+	// Returns the city containing the point, if the point is not in any city boundary,
+	// returns the city of the nearest cinema.
+	ResolveCity(ctx context.Context, point models.GeoPoint) (models.ResolvedCity, error)
+
+	ListCountries(ctx context.Context) ([]models.Country, error)
+	ListRegions(ctx context.Context, countryID int32) ([]models.Region, error)
+	// Returns the region cities where there are cinemas.
+	GetRegionCities(ctx context.Context, regionID int32) ([]models.City, error)
+
+	// Returns movies with the screenings in the city in the next horizonDays days.
+	// If horizonDays is 0, the default horizon is used.
+	GetNowShowing(ctx context.Context, cityID int32, horizonDays uint32,
+		filter models.ScreeningsFilter) ([]models.CityMovie, error)
+	// Returns movies which first screening in the city is beyond the horizon, the horizon is the same as in GetNowShowing.
+	GetComingSoon(ctx context.Context, cityID int32, horizonDays uint32,
+		filter models.ScreeningsFilter) ([]models.CityMovie, error)
+
+	// Returns movies of the cinema with their next screenings, if perMovieLimit is 0, the default limit is used.
+	GetCinemaUpcoming(ctx context.Context, cinemaID int32, perMovieLimit int,
+		filter models.ScreeningsFilter) ([]models.MovieUpcomingScreenings, error)
+
+	// Returns the alternatives of the screening: the same movie in the same cinema,
+	// then in the nearest cinemas of the city. If limit is 0, the default limit is used.
+	GetAlternatives(ctx context.Context, screeningID int64, limit int,
+		filter models.ScreeningsFilter) ([]models.AlternativeScreening, error)
+
+	// Returns cinemas with specified ids in the order of the ids and ids of the not found cinemas.
+	GetCinemasByIds(ctx context.Context, ids []int32) (cinemas []models.Cinema, missingIDs []int32, err error)
+
+	// Returns screenings with specified ids in the order of the ids and ids of the not found screenings.
+	// If enrich is true, the movie title and the end time are received from the movie catalog.
+	GetScreeningsByIds(ctx context.Context, ids []int64,
+		enrich bool) (screenings []models.Screening, missingIDs []int64, err error)
 }
 
 type cinemaService struct {
-	r repository.CinemaRepository
+	logger *logrus.Logger
+	r      repository.CinemaRepository
+	// may be nil, if seats availability provider is not configured
+	seatsProvider SeatAvailabilityProvider
+	mediaStorage  MediaStorage
+	// may be nil, if movie catalog is not configured
+	movieCatalog MovieCatalog
 }
 
-func NewCinemaService(r repository.CinemaRepository) *cinemaService {
-	return &cinemaService{r: r}
+func NewCinemaService(logger *logrus.Logger, r repository.CinemaRepository,
+	seatsProvider SeatAvailabilityProvider, mediaStorage MediaStorage, movieCatalog MovieCatalog) *cinemaService {
+	return &cinemaService{
+		logger:        logger,
+		r:             r,
+		seatsProvider: seatsProvider,
+		mediaStorage:  mediaStorage,
+		movieCatalog:  movieCatalog,
+	}
 }
 
-func (s *cinemaService) GetCinemasInCity(ctx context.Context, id int32) ([]models.Cinema, error) {
-	return s.r.GetCinemasInCity(ctx, id)
+func (s *cinemaService) GetCinemasInCity(ctx context.Context, id int32,
+	filter models.CinemasFilter) ([]models.Cinema, error) {
+	cinemas, err := s.r.GetCinemasInCity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]models.Cinema, 0, len(cinemas))
+	for i := range cinemas {
+		if filter.Match(&cinemas[i]) {
+			filtered = append(filtered, cinemas[i])
+		}
+	}
+	return filtered, nil
 }
 
 func (s *cinemaService) GetMoviesScreenings(
 	ctx context.Context,
 	cinemaID int32,
-	startPeriod, endPeriod time.Time) ([]models.MoviesScreenings, error) {
-	return s.r.GetMoviesScreenings(ctx, cinemaID, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter) ([]models.MoviesScreenings, error) {
+	return s.r.GetMoviesScreenings(ctx, cinemaID, startPeriod, endPeriod, filter)
 }
 
 func (s *cinemaService) GetMoviesScreeningsInCities(
 	ctx context.Context,
-	citiesIDs []int32,
-	startPeriod, endPeriod time.Time) (screenings []models.MoviesScreenings, err error) {
+	citiesIDs, regionsIDs []int32,
+	startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter,
+	includeStats bool) (screenings []models.MoviesScreenings, err error) {
+	if len(regionsIDs) > 0 {
+		regionsCitiesIDs, err := s.r.GetRegionsCitiesIDs(ctx, regionsIDs)
+		if err != nil {
+			return nil, err
+		}
+		// the regions have no cities, so there are no screenings
+		if len(regionsCitiesIDs) == 0 && len(citiesIDs) == 0 {
+			return []models.MoviesScreenings{}, nil
+		}
+		citiesIDs = append(slices.Clone(citiesIDs), regionsCitiesIDs...)
+	}
+
 	if len(citiesIDs) == 0 {
-		screenings, err = s.r.GetAllMoviesScreenings(ctx, startPeriod, endPeriod)
+		screenings, err = s.r.GetAllMoviesScreenings(ctx, startPeriod, endPeriod, filter, includeStats)
 	} else {
-		screenings, err = s.r.GetMoviesScreeningsInCities(ctx, citiesIDs, startPeriod, endPeriod)
+		screenings, err = s.r.GetMoviesScreeningsInCities(ctx, citiesIDs, startPeriod, endPeriod, filter, includeStats)
 	}
 	return
 }
 
 func (s *cinemaService) GetScreenings(ctx context.Context,
 	cinemaID, movieID int32,
-	startPeriod, endPeriod time.Time) ([]models.Screening, error) {
-	return s.r.GetScreenings(ctx, cinemaID, movieID, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter, enrich bool) ([]models.Screening, error) {
+	screenings, err := s.r.GetScreenings(ctx, cinemaID, movieID, startPeriod, endPeriod, filter)
+	if err != nil || !enrich {
+		return screenings, err
+	}
+	return s.enrichScreenings(ctx, screenings), nil
 }
 
 func (s *cinemaService) GetCityScreenings(ctx context.Context,
 	cityID, movieID int32,
-	startPeriod, endPeriod time.Time) ([]models.CityScreening, error) {
-	return s.r.GetCityScreenings(ctx, cityID, movieID, startPeriod, endPeriod)
+	startPeriod, endPeriod time.Time,
+	filter models.ScreeningsFilter, enrich bool) ([]models.CityScreening, error) {
+	screenings, err := s.r.GetCityScreenings(ctx, cityID, movieID, startPeriod, endPeriod, filter)
+	if err != nil || !enrich {
+		return screenings, err
+	}
+	return s.enrichCityScreenings(ctx, movieID, screenings), nil
 }
 
-func (s *cinemaService) GetScreening(ctx context.Context, id int64) (models.Screening, error) {
-	return s.r.GetScreening(ctx, id)
+func (s *cinemaService) GetScreening(ctx context.Context, id int64, enrich bool) (models.Screening, error) {
+	screening, err := s.r.GetScreening(ctx, id)
+	if err != nil || !enrich {
+		return screening, err
+	}
+	return s.enrichScreenings(ctx, []models.Screening{screening})[0], nil
 }
 
 func (s *cinemaService) GetCinemasCities(ctx context.Context) ([]models.City, error) {
 	return s.r.GetCinemasCities(ctx)
 }
 
-func (s *cinemaService) GetHallConfiguraion(ctx context.Context, id int32) ([]models.Place, error) {
-	return s.r.GetHallConfiguraion(ctx, id)
+func (s *cinemaService) GetHallConfiguraion(ctx context.Context, id int32) (models.HallConfiguration, error) {
+	places, err := s.r.GetHallConfiguraion(ctx, id)
+	if err != nil {
+		return models.HallConfiguration{}, err
+	}
+
+	return models.HallConfiguration{
+		Places:      places,
+		TotalPlaces: uint32(len(places)),
+	}, nil
+}
+
+func (s *cinemaService) GetHallConfigurationWithAvailability(ctx context.Context,
+	hallID int32, screeningID int64) (models.HallConfiguration, error) {
+	configuration, err := s.GetHallConfiguraion(ctx, hallID)
+	if err != nil || s.seatsProvider == nil {
+		return configuration, err
+	}
+
+	screening, err := s.r.GetScreening(ctx, screeningID)
+	if err != nil {
+		return models.HallConfiguration{}, err
+	}
+	if screening.HallID != hallID {
+		return models.HallConfiguration{}, models.Errorf(models.InvalidArgument,
+			"screening %d is not in the hall %d", screeningID, hallID)
+	}
+
+	occupied, err := s.seatsProvider.GetOccupiedPlaces(ctx, screeningID)
+	if err != nil {
+		s.logger.Warnf("can't get places availability for screening %d, availability is unknown: %v", screeningID, err)
+		return configuration, nil
+	}
+
+	free := mergeAvailability(configuration.Places, occupied)
+	configuration.FreePlaces = &free
+	return configuration, nil
+}
+
+func (s *cinemaService) ListEvents(ctx context.Context, cityID int32) ([]models.Event, error) {
+	return s.r.ListEvents(ctx, cityID)
+}
+
+func (s *cinemaService) GetEventScreenings(ctx context.Context,
+	eventID int32, filter models.ScreeningsFilter) ([]models.Screening, error) {
+	return s.r.GetEventScreenings(ctx, eventID, filter)
+}
+
+func (s *cinemaService) ListChains(ctx context.Context, cityID int32) ([]models.Chain, error) {
+	return s.r.ListChains(ctx, cityID)
+}
+
+func (s *cinemaService) ExportCinemas(ctx context.Context, filter models.CinemasExportFilter,
+	format models.GeoFormat, fn func(models.CinemaFeature) error) error {
+	return s.r.ExportCinemas(ctx, filter, format, fn)
+}
+
+func (s *cinemaService) ListCountries(ctx context.Context) ([]models.Country, error) {
+	return s.r.ListCountries(ctx)
+}
+
+func (s *cinemaService) ListRegions(ctx context.Context, countryID int32) ([]models.Region, error) {
+	return s.r.ListRegions(ctx, countryID)
+}
+
+func (s *cinemaService) GetRegionCities(ctx context.Context, regionID int32) ([]models.City, error) {
+	return s.r.GetRegionCities(ctx, regionID)
 }
 
 func (s *cinemaService) GetCinema(ctx context.Context, id int32) (models.Cinema, error) {
 	return s.r.GetCinema(ctx, id)
 }
 
+func (s *cinemaService) GetCinemaHalls(ctx context.Context, cinemaID int32) ([]models.Hall, error) {
+	halls, err := s.r.GetCinemaHalls(ctx, cinemaID)
+	if err != nil || len(halls) == 0 {
+		return halls, err
+	}
+
+	return s.fillHallsMaintenance(ctx, halls)
+}
+
 func (s *cinemaService) GetHalls(ctx context.Context, ids []int32) ([]models.Hall, error) {
-	return s.r.GetHalls(ctx, ids)
+	halls, err := s.r.GetHalls(ctx, ids)
+	if err != nil || len(halls) == 0 {
+		return halls, err
+	}
+
+	return s.fillHallsMaintenance(ctx, halls)
 }
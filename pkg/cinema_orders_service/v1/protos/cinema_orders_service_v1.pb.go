@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.3
+// source: cinema_orders_service_v1.proto
+
+// Client side copy of the cinema orders service API, contains only used methods.
+
+package protos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetOccupiedPlacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID int64 `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+}
+
+func (x *GetOccupiedPlacesRequest) Reset() {
+	*x = GetOccupiedPlacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_orders_service_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOccupiedPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccupiedPlacesRequest) ProtoMessage() {}
+
+func (x *GetOccupiedPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_orders_service_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccupiedPlacesRequest.ProtoReflect.Descriptor instead.
+func (*GetOccupiedPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_orders_service_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetOccupiedPlacesRequest) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+type Place struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row  int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Seat int32 `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_orders_service_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_orders_service_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_cinema_orders_service_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Place) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Place) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+type Places struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Places []*Place `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
+}
+
+func (x *Places) Reset() {
+	*x = Places{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_orders_service_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Places) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Places) ProtoMessage() {}
+
+func (x *Places) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_orders_service_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Places.ProtoReflect.Descriptor instead.
+func (*Places) Descriptor() ([]byte, []int) {
+	return file_cinema_orders_service_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Places) GetPlaces() []*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+var File_cinema_orders_service_v1_proto protoreflect.FileDescriptor
+
+var file_cinema_orders_service_v1_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x15, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x63,
+	0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x22, 0x3e, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x32, 0x7c, 0x0a, 0x15, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x63,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x63, 0x63, 0x75, 0x70, 0x69, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x42, 0x21, 0x5a, 0x1f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cinema_orders_service_v1_proto_rawDescOnce sync.Once
+	file_cinema_orders_service_v1_proto_rawDescData = file_cinema_orders_service_v1_proto_rawDesc
+)
+
+func file_cinema_orders_service_v1_proto_rawDescGZIP() []byte {
+	file_cinema_orders_service_v1_proto_rawDescOnce.Do(func() {
+		file_cinema_orders_service_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_cinema_orders_service_v1_proto_rawDescData)
+	})
+	return file_cinema_orders_service_v1_proto_rawDescData
+}
+
+var file_cinema_orders_service_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cinema_orders_service_v1_proto_goTypes = []interface{}{
+	(*GetOccupiedPlacesRequest)(nil), // 0: cinema_orders_service.GetOccupiedPlacesRequest
+	(*Place)(nil),                    // 1: cinema_orders_service.Place
+	(*Places)(nil),                   // 2: cinema_orders_service.Places
+}
+var file_cinema_orders_service_v1_proto_depIdxs = []int32{
+	1, // 0: cinema_orders_service.Places.places:type_name -> cinema_orders_service.Place
+	0, // 1: cinema_orders_service.cinemaOrdersServiceV1.GetOccupiedPlaces:input_type -> cinema_orders_service.GetOccupiedPlacesRequest
+	2, // 2: cinema_orders_service.cinemaOrdersServiceV1.GetOccupiedPlaces:output_type -> cinema_orders_service.Places
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cinema_orders_service_v1_proto_init() }
+func file_cinema_orders_service_v1_proto_init() {
+	if File_cinema_orders_service_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cinema_orders_service_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOccupiedPlacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_orders_service_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Place); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_orders_service_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Places); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cinema_orders_service_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cinema_orders_service_v1_proto_goTypes,
+		DependencyIndexes: file_cinema_orders_service_v1_proto_depIdxs,
+		MessageInfos:      file_cinema_orders_service_v1_proto_msgTypes,
+	}.Build()
+	File_cinema_orders_service_v1_proto = out.File
+	file_cinema_orders_service_v1_proto_rawDesc = nil
+	file_cinema_orders_service_v1_proto_goTypes = nil
+	file_cinema_orders_service_v1_proto_depIdxs = nil
+}
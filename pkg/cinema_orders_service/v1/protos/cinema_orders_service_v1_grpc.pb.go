@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.3
+// source: cinema_orders_service_v1.proto
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CinemaOrdersServiceV1Client is the client API for CinemaOrdersServiceV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CinemaOrdersServiceV1Client interface {
+	// Returns the places that are already occupied for the screening.
+	GetOccupiedPlaces(ctx context.Context, in *GetOccupiedPlacesRequest, opts ...grpc.CallOption) (*Places, error)
+}
+
+type cinemaOrdersServiceV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCinemaOrdersServiceV1Client(cc grpc.ClientConnInterface) CinemaOrdersServiceV1Client {
+	return &cinemaOrdersServiceV1Client{cc}
+}
+
+func (c *cinemaOrdersServiceV1Client) GetOccupiedPlaces(ctx context.Context, in *GetOccupiedPlacesRequest, opts ...grpc.CallOption) (*Places, error) {
+	out := new(Places)
+	err := c.cc.Invoke(ctx, "/cinema_orders_service.cinemaOrdersServiceV1/GetOccupiedPlaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CinemaOrdersServiceV1Server is the server API for CinemaOrdersServiceV1 service.
+// All implementations must embed UnimplementedCinemaOrdersServiceV1Server
+// for forward compatibility
+type CinemaOrdersServiceV1Server interface {
+	// Returns the places that are already occupied for the screening.
+	GetOccupiedPlaces(context.Context, *GetOccupiedPlacesRequest) (*Places, error)
+	mustEmbedUnimplementedCinemaOrdersServiceV1Server()
+}
+
+// UnimplementedCinemaOrdersServiceV1Server must be embedded to have forward compatible implementations.
+type UnimplementedCinemaOrdersServiceV1Server struct {
+}
+
+func (UnimplementedCinemaOrdersServiceV1Server) GetOccupiedPlaces(context.Context, *GetOccupiedPlacesRequest) (*Places, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOccupiedPlaces not implemented")
+}
+func (UnimplementedCinemaOrdersServiceV1Server) mustEmbedUnimplementedCinemaOrdersServiceV1Server() {}
+
+// UnsafeCinemaOrdersServiceV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CinemaOrdersServiceV1Server will
+// result in compilation errors.
+type UnsafeCinemaOrdersServiceV1Server interface {
+	mustEmbedUnimplementedCinemaOrdersServiceV1Server()
+}
+
+func RegisterCinemaOrdersServiceV1Server(s grpc.ServiceRegistrar, srv CinemaOrdersServiceV1Server) {
+	s.RegisterService(&CinemaOrdersServiceV1_ServiceDesc, srv)
+}
+
+func _CinemaOrdersServiceV1_GetOccupiedPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOccupiedPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaOrdersServiceV1Server).GetOccupiedPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_orders_service.cinemaOrdersServiceV1/GetOccupiedPlaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaOrdersServiceV1Server).GetOccupiedPlaces(ctx, req.(*GetOccupiedPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CinemaOrdersServiceV1_ServiceDesc is the grpc.ServiceDesc for CinemaOrdersServiceV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CinemaOrdersServiceV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cinema_orders_service.cinemaOrdersServiceV1",
+	HandlerType: (*CinemaOrdersServiceV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOccupiedPlaces",
+			Handler:    _CinemaOrdersServiceV1_GetOccupiedPlaces_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cinema_orders_service_v1.proto",
+}
@@ -36,184 +36,414 @@ var file_cinema_service_v1_proto_rawDesc = []byte{
 	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd1, 0x13, 0x0a, 0x0f, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xb3, 0x30, 0x0a, 0x0f, 0x63, 0x69, 0x6e, 0x65, 0x6d,
 	0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65,
 	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x12,
 	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0xdb, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x84, 0x01, 0x92, 0x41, 0x5e, 0x4a,
-	0x5c, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x55, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x63,
-	0x69, 0x74, 0x79, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d,
-	0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f,
-	0x7b, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73,
-	0x12, 0xc6, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x20,
-	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x22, 0x7f, 0x92, 0x41, 0x5f, 0x4a, 0x5d, 0x0a,
-	0x03, 0x34, 0x30, 0x34, 0x12, 0x56, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x77, 0x69, 0x74,
-	0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b,
-	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x12, 0xea, 0x02, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x02, 0x92, 0x41, 0xe7, 0x01, 0x4a, 0x82, 0x01, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x7b, 0x0a, 0x56, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x20, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f,
-	0x74, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x12, 0x21,
-	0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4a, 0x60, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x59, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e,
-	0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x49, 0x44, 0x7d, 0x12, 0xce, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f,
-	0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a,
-	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe7, 0x01,
-	0x92, 0x41, 0xb4, 0x01, 0x4a, 0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a,
-	0x67, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x60, 0x0a, 0x5e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x73, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x77, 0x65, 0x72,
-	0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x64, 0x75, 0x72, 0x69,
-	0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0xd8, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d,
-	0x6f, 0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49,
-	0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69,
-	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe1,
-	0x01, 0x92, 0x41, 0xc0, 0x01, 0x4a, 0x57, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x50, 0x0a, 0x4e,
+	0x65, 0x73, 0x12, 0x79, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73,
+	0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0xc6, 0x01,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x20, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x22, 0x7f, 0x92, 0x41, 0x5f, 0x4a, 0x5d, 0x0a, 0x03, 0x34, 0x30,
+	0x34, 0x12, 0x56, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x12, 0xea, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x8e, 0x02, 0x92, 0x41, 0xe7, 0x01, 0x4a, 0x82, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x7b, 0x0a, 0x56, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x73, 0x6b, 0x20, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a,
+	0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x60,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x59, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+	0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x21, 0x0a,
+	0x1f, 0x1a, 0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x44, 0x7d, 0x12, 0xe3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7d, 0x92, 0x41, 0x4b, 0x4a,
+	0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x69, 0x73, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
+	0x12, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0xef, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e,
+	0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x79, 0x92, 0x41, 0x59, 0x4a, 0x57, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x50, 0x0a, 0x4e,
 	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70,
 	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65,
 	0x72, 0x69, 0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73,
-	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a, 0x65,
-	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x5e, 0x0a, 0x5c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
-	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
-	0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65,
-	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6d, 0x6f, 0x76, 0x69,
-	0x65, 0x73, 0x12, 0xb7, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x2e, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xd3, 0x01, 0x92, 0x41, 0xab, 0x01, 0x4a, 0x49, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20,
-	0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
-	0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a, 0x5e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
-	0x57, 0x0a, 0x55, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
-	0x20, 0x6e, 0x6f, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x77,
-	0x65, 0x72, 0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x63, 0x69, 0x74, 0x79, 0x20, 0x64, 0x75, 0x72, 0x69,
-	0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44,
-	0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x55, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x73,
-	0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x61,
-	0x6c, 0x6c, 0x73, 0x12, 0xc5, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69,
-	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72,
-	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xf1, 0x01, 0x92, 0x41, 0xc5, 0x01, 0x4a, 0x49,
-	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0xd5, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43,
+	0x69, 0x74, 0x79, 0x12, 0x2a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x72, 0x92, 0x41, 0x4b, 0x4a, 0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x79, 0x2f,
+	0x7b, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x55, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12,
+	0x09, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x76,
+	0x92, 0x41, 0x4b, 0x4a, 0x49, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x42, 0x0a, 0x40, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x2f, 0x7b, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xcc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x61,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x64, 0x92, 0x41, 0x3a, 0x4a, 0x38, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x31, 0x0a, 0x2f, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x68, 0x61, 0x6c,
+	0x6c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x61, 0x6c, 0x6c, 0x2f, 0x7b,
+	0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8a, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xaa, 0x01, 0x92, 0x41, 0xa6, 0x01, 0x4a, 0x65, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x5e, 0x0a, 0x5c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x2e, 0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x36, 0x0a, 0x34, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x12, 0x69, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x63,
+	0x69, 0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xc1, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x64, 0x92, 0x41, 0x3b,
+	0x4a, 0x39, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x32, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0xd6, 0x02, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x01, 0x92, 0x41, 0xed, 0x01, 0x4a, 0x64, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x5d, 0x0a, 0x5b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x2e, 0x4a, 0x38, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x31, 0x0a, 0x2f, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x68, 0x61, 0x6c, 0x6c,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+	0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x4a, 0x4b, 0x0a,
+	0x03, 0x34, 0x30, 0x39, 0x12, 0x44, 0x0a, 0x42, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x61, 0x6c, 0x6c, 0x20, 0x69,
+	0x73, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x12, 0x80, 0x02, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x85, 0x01, 0x92, 0x41, 0x81, 0x01, 0x4a, 0x45, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x3e, 0x0a, 0x3c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x2e, 0x4a, 0x38, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x31, 0x0a, 0x2f, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x68, 0x61, 0x6c, 0x6c,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+	0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x79, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x31, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x00, 0x12, 0xf1, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x28,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x92, 0x41, 0x82, 0x01, 0x4a, 0x44, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x3d, 0x0a, 0x3b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x20, 0x69, 0x73, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x75, 0x6e, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e,
+	0x4a, 0x3a, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x33, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69,
+	0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x69, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x7d,
+	0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x12, 0x1d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x53, 0x92, 0x41, 0x3e, 0x4a, 0x3c, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x35, 0x0a,
+	0x33, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x20, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x72,
+	0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x61, 0x92, 0x41, 0x3f,
+	0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x36, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x20,
+	0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xbe,
+	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x6e, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x73, 0x49, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x22, 0x5c, 0x92, 0x41, 0x3f, 0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x36, 0x0a,
+	0x34, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x20,
+	0x6f, 0x72, 0x20, 0x7a, 0x6f, 0x6f, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0xeb, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12,
+	0x22, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x92, 0x41, 0x75, 0x4a, 0x39,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x32, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
 	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x6f, 0x72,
-	0x20, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e,
-	0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a, 0x78, 0x0a, 0x03, 0x34, 0x30, 0x34,
-	0x12, 0x71, 0x0a, 0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
-	0x6e, 0x20, 0x6e, 0x6f, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x20,
-	0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6d,
-	0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
-	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d,
-	0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xcc, 0x01, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x92, 0x41, 0x3a, 0x4a, 0x38, 0x0a, 0x03, 0x34, 0x30, 0x34,
+	0x20, 0x6c, 0x61, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x6c, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a, 0x38, 0x0a, 0x03, 0x34, 0x30, 0x34,
 	0x12, 0x31, 0x0a, 0x2f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
-	0x6e, 0x20, 0x68, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x68,
-	0x61, 0x6c, 0x6c, 0x2f, 0x7b, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xb8, 0x02, 0x92, 0x41, 0x9a,
-	0x02, 0x12, 0x56, 0x0a, 0x0e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x07, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74, 0x12, 0x1a,
-	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74, 0x1a, 0x18, 0x74, 0x69, 0x6d, 0x75,
-	0x72, 0x2e, 0x73, 0x69, 0x6e, 0x65, 0x6c, 0x6e, 0x69, 0x6b, 0x40, 0x79, 0x61, 0x6e, 0x64, 0x65,
-	0x78, 0x2e, 0x72, 0x75, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x52, 0x56, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x4f, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72,
-	0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x41, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
-	0x3a, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x65, 0x6e,
-	0x74, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x20, 0x63,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x59, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x77, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x26, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f,
+	0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x77, 0x53, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74,
+	0x79, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x22, 0x64, 0x92, 0x41, 0x35, 0x4a,
+	0x33, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x2c, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x20, 0x64, 0x61, 0x79, 0x73, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x77, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x12, 0xb7, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x6f,
+	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x22, 0x64, 0x92, 0x41, 0x35, 0x4a, 0x33, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x2c, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x64, 0x61, 0x79, 0x73,
+	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x79, 0x2f, 0x7b,
+	0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x2f, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x6f, 0x6f, 0x6e, 0x12, 0xdd, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x28, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x73, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x74, 0x92, 0x41, 0x40, 0x4a, 0x3e, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x37, 0x0a, 0x35, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x70, 0x65, 0x72, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x2f, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x8b, 0x02, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xa8, 0x01,
+	0x92, 0x41, 0x75, 0x4a, 0x34, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x2d, 0x0a, 0x2b, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x34,
+	0x12, 0x36, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x7d, 0x2f, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0xc2, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x79, 0x49,
+	0x64, 0x73, 0x22, 0x69, 0x92, 0x41, 0x53, 0x4a, 0x51, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4a,
+	0x0a, 0x48, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x74,
+	0x6f, 0x6f, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0xce, 0x01,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42,
+	0x79, 0x49, 0x64, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73,
+	0x22, 0x6c, 0x92, 0x41, 0x53, 0x4a, 0x51, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4a, 0x0a, 0x48,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x6f,
+	0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xab,
+	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x48, 0x61, 0x6c, 0x6c,
+	0x73, 0x12, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x48, 0x61, 0x6c, 0x6c,
+	0x73, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x62, 0x92, 0x41, 0x3c, 0x4a, 0x3a, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x33, 0x0a, 0x31, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x69, 0x64, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x2f, 0x7b, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x49, 0x44, 0x7d, 0x2f, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x42, 0xb9, 0x02, 0x92,
+	0x41, 0x9b, 0x02, 0x12, 0x56, 0x0a, 0x0e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x20, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x07, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74,
+	0x12, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x46, 0x61, 0x6c, 0x6f, 0x6b, 0x75, 0x74, 0x1a, 0x18, 0x74, 0x69,
+	0x6d, 0x75, 0x72, 0x2e, 0x73, 0x69, 0x6e, 0x65, 0x6c, 0x6e, 0x69, 0x6b, 0x40, 0x79, 0x61, 0x6e,
+	0x64, 0x65, 0x78, 0x2e, 0x72, 0x75, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x52, 0x56, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x4f, 0x0a, 0x2a, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a, 0x1d, 0x23, 0x2f,
 	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5a, 0x18, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x41, 0x0a, 0x03, 0x35,
+	0x30, 0x30, 0x12, 0x3a, 0x0a, 0x15, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20,
+	0x77, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x2e, 0x12, 0x21, 0x0a, 0x1f, 0x1a,
+	0x1d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5a, 0x18,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_cinema_service_v1_proto_goTypes = []interface{}{
@@ -227,15 +457,52 @@ var file_cinema_service_v1_proto_goTypes = []interface{}{
 	(*GetHallsRequest)(nil),                    // 7: cinema_service.GetHallsRequest
 	(*GetScreeningsRequest)(nil),               // 8: cinema_service.GetScreeningsRequest
 	(*GetHallConfigurationRequest)(nil),        // 9: cinema_service.GetHallConfigurationRequest
-	(*Cities)(nil),                             // 10: cinema_service.Cities
-	(*Cinemas)(nil),                            // 11: cinema_service.Cinemas
-	(*Cinema)(nil),                             // 12: cinema_service.Cinema
-	(*GetScreeningResponse)(nil),               // 13: cinema_service.GetScreeningResponse
-	(*PreviewScreenings)(nil),                  // 14: cinema_service.PreviewScreenings
-	(*CityScreenings)(nil),                     // 15: cinema_service.CityScreenings
-	(*Halls)(nil),                              // 16: cinema_service.Halls
-	(*Screenings)(nil),                         // 17: cinema_service.Screenings
-	(*HallConfiguration)(nil),                  // 18: cinema_service.HallConfiguration
+	(*UpdateScreeningStatusRequest)(nil),       // 10: cinema_service.UpdateScreeningStatusRequest
+	(*ListEventsRequest)(nil),                  // 11: cinema_service.ListEventsRequest
+	(*GetEventScreeningsRequest)(nil),          // 12: cinema_service.GetEventScreeningsRequest
+	(*CreateScreeningRequest)(nil),             // 13: cinema_service.CreateScreeningRequest
+	(*CreateHallMaintenanceWindowRequest)(nil), // 14: cinema_service.CreateHallMaintenanceWindowRequest
+	(*ListHallMaintenanceWindowsRequest)(nil),  // 15: cinema_service.ListHallMaintenanceWindowsRequest
+	(*UploadCinemaPhotoRequest)(nil),           // 16: cinema_service.UploadCinemaPhotoRequest
+	(*ListChainsRequest)(nil),                  // 17: cinema_service.ListChainsRequest
+	(*SearchRequest)(nil),                      // 18: cinema_service.SearchRequest
+	(*AutocompleteCitiesRequest)(nil),          // 19: cinema_service.AutocompleteCitiesRequest
+	(*GetCinemasInBoundsRequest)(nil),          // 20: cinema_service.GetCinemasInBoundsRequest
+	(*ResolveCityRequest)(nil),                 // 21: cinema_service.ResolveCityRequest
+	(*ListRegionsRequest)(nil),                 // 22: cinema_service.ListRegionsRequest
+	(*GetRegionCitiesRequest)(nil),             // 23: cinema_service.GetRegionCitiesRequest
+	(*GetCityMoviesRequest)(nil),               // 24: cinema_service.GetCityMoviesRequest
+	(*GetCinemaUpcomingRequest)(nil),           // 25: cinema_service.GetCinemaUpcomingRequest
+	(*GetAlternativesRequest)(nil),             // 26: cinema_service.GetAlternativesRequest
+	(*GetCinemasByIdsRequest)(nil),             // 27: cinema_service.GetCinemasByIdsRequest
+	(*GetScreeningsByIdsRequest)(nil),          // 28: cinema_service.GetScreeningsByIdsRequest
+	(*GetCinemaHalls)(nil),                     // 29: cinema_service.GetCinemaHalls
+	(*Cities)(nil),                             // 30: cinema_service.Cities
+	(*Cinemas)(nil),                            // 31: cinema_service.Cinemas
+	(*Cinema)(nil),                             // 32: cinema_service.Cinema
+	(*GetScreeningResponse)(nil),               // 33: cinema_service.GetScreeningResponse
+	(*PreviewScreenings)(nil),                  // 34: cinema_service.PreviewScreenings
+	(*CityScreenings)(nil),                     // 35: cinema_service.CityScreenings
+	(*Halls)(nil),                              // 36: cinema_service.Halls
+	(*Screenings)(nil),                         // 37: cinema_service.Screenings
+	(*HallConfiguration)(nil),                  // 38: cinema_service.HallConfiguration
+	(*Events)(nil),                             // 39: cinema_service.Events
+	(*CreateScreeningResponse)(nil),            // 40: cinema_service.CreateScreeningResponse
+	(*HallMaintenanceWindow)(nil),              // 41: cinema_service.HallMaintenanceWindow
+	(*HallMaintenanceWindows)(nil),             // 42: cinema_service.HallMaintenanceWindows
+	(*UploadCinemaPhotoResponse)(nil),          // 43: cinema_service.UploadCinemaPhotoResponse
+	(*Chains)(nil),                             // 44: cinema_service.Chains
+	(*SearchResponse)(nil),                     // 45: cinema_service.SearchResponse
+	(*CitiesSuggestions)(nil),                  // 46: cinema_service.CitiesSuggestions
+	(*CinemasInBounds)(nil),                    // 47: cinema_service.CinemasInBounds
+	(*ResolveCityResponse)(nil),                // 48: cinema_service.ResolveCityResponse
+	(*Countries)(nil),                          // 49: cinema_service.Countries
+	(*Regions)(nil),                            // 50: cinema_service.Regions
+	(*CityMovies)(nil),                         // 51: cinema_service.CityMovies
+	(*MoviesUpcomingScreenings)(nil),           // 52: cinema_service.MoviesUpcomingScreenings
+	(*AlternativeScreenings)(nil),              // 53: cinema_service.AlternativeScreenings
+	(*CinemasByIds)(nil),                       // 54: cinema_service.CinemasByIds
+	(*ScreeningsByIds)(nil),                    // 55: cinema_service.ScreeningsByIds
 }
 var file_cinema_service_v1_proto_depIdxs = []int32{
 	0,  // 0: cinema_service.cinemaServiceV1.GetCinemasCities:input_type -> google.protobuf.Empty
@@ -248,18 +515,62 @@ var file_cinema_service_v1_proto_depIdxs = []int32{
 	7,  // 7: cinema_service.cinemaServiceV1.GetHalls:input_type -> cinema_service.GetHallsRequest
 	8,  // 8: cinema_service.cinemaServiceV1.GetScreenings:input_type -> cinema_service.GetScreeningsRequest
 	9,  // 9: cinema_service.cinemaServiceV1.GetHallConfiguration:input_type -> cinema_service.GetHallConfigurationRequest
-	10, // 10: cinema_service.cinemaServiceV1.GetCinemasCities:output_type -> cinema_service.Cities
-	11, // 11: cinema_service.cinemaServiceV1.GetCinemasInCity:output_type -> cinema_service.Cinemas
-	12, // 12: cinema_service.cinemaServiceV1.GetCinema:output_type -> cinema_service.Cinema
-	13, // 13: cinema_service.cinemaServiceV1.GetScreening:output_type -> cinema_service.GetScreeningResponse
-	14, // 14: cinema_service.cinemaServiceV1.GetMoviesScreenings:output_type -> cinema_service.PreviewScreenings
-	14, // 15: cinema_service.cinemaServiceV1.GetMoviesScreeningsInCities:output_type -> cinema_service.PreviewScreenings
-	15, // 16: cinema_service.cinemaServiceV1.GetScreeningsInCity:output_type -> cinema_service.CityScreenings
-	16, // 17: cinema_service.cinemaServiceV1.GetHalls:output_type -> cinema_service.Halls
-	17, // 18: cinema_service.cinemaServiceV1.GetScreenings:output_type -> cinema_service.Screenings
-	18, // 19: cinema_service.cinemaServiceV1.GetHallConfiguration:output_type -> cinema_service.HallConfiguration
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	10, // 10: cinema_service.cinemaServiceV1.UpdateScreeningStatus:input_type -> cinema_service.UpdateScreeningStatusRequest
+	11, // 11: cinema_service.cinemaServiceV1.ListEvents:input_type -> cinema_service.ListEventsRequest
+	12, // 12: cinema_service.cinemaServiceV1.GetEventScreenings:input_type -> cinema_service.GetEventScreeningsRequest
+	13, // 13: cinema_service.cinemaServiceV1.CreateScreening:input_type -> cinema_service.CreateScreeningRequest
+	14, // 14: cinema_service.cinemaServiceV1.CreateHallMaintenanceWindow:input_type -> cinema_service.CreateHallMaintenanceWindowRequest
+	15, // 15: cinema_service.cinemaServiceV1.ListHallMaintenanceWindows:input_type -> cinema_service.ListHallMaintenanceWindowsRequest
+	16, // 16: cinema_service.cinemaServiceV1.UploadCinemaPhoto:input_type -> cinema_service.UploadCinemaPhotoRequest
+	17, // 17: cinema_service.cinemaServiceV1.ListChains:input_type -> cinema_service.ListChainsRequest
+	18, // 18: cinema_service.cinemaServiceV1.Search:input_type -> cinema_service.SearchRequest
+	19, // 19: cinema_service.cinemaServiceV1.AutocompleteCities:input_type -> cinema_service.AutocompleteCitiesRequest
+	20, // 20: cinema_service.cinemaServiceV1.GetCinemasInBounds:input_type -> cinema_service.GetCinemasInBoundsRequest
+	21, // 21: cinema_service.cinemaServiceV1.ResolveCity:input_type -> cinema_service.ResolveCityRequest
+	0,  // 22: cinema_service.cinemaServiceV1.ListCountries:input_type -> google.protobuf.Empty
+	22, // 23: cinema_service.cinemaServiceV1.ListRegions:input_type -> cinema_service.ListRegionsRequest
+	23, // 24: cinema_service.cinemaServiceV1.GetRegionCities:input_type -> cinema_service.GetRegionCitiesRequest
+	24, // 25: cinema_service.cinemaServiceV1.GetNowShowing:input_type -> cinema_service.GetCityMoviesRequest
+	24, // 26: cinema_service.cinemaServiceV1.GetComingSoon:input_type -> cinema_service.GetCityMoviesRequest
+	25, // 27: cinema_service.cinemaServiceV1.GetCinemaUpcoming:input_type -> cinema_service.GetCinemaUpcomingRequest
+	26, // 28: cinema_service.cinemaServiceV1.GetAlternatives:input_type -> cinema_service.GetAlternativesRequest
+	27, // 29: cinema_service.cinemaServiceV1.GetCinemasByIds:input_type -> cinema_service.GetCinemasByIdsRequest
+	28, // 30: cinema_service.cinemaServiceV1.GetScreeningsByIds:input_type -> cinema_service.GetScreeningsByIdsRequest
+	29, // 31: cinema_service.cinemaServiceV1.GetCinemaHalls:input_type -> cinema_service.GetCinemaHalls
+	30, // 32: cinema_service.cinemaServiceV1.GetCinemasCities:output_type -> cinema_service.Cities
+	31, // 33: cinema_service.cinemaServiceV1.GetCinemasInCity:output_type -> cinema_service.Cinemas
+	32, // 34: cinema_service.cinemaServiceV1.GetCinema:output_type -> cinema_service.Cinema
+	33, // 35: cinema_service.cinemaServiceV1.GetScreening:output_type -> cinema_service.GetScreeningResponse
+	34, // 36: cinema_service.cinemaServiceV1.GetMoviesScreenings:output_type -> cinema_service.PreviewScreenings
+	34, // 37: cinema_service.cinemaServiceV1.GetMoviesScreeningsInCities:output_type -> cinema_service.PreviewScreenings
+	35, // 38: cinema_service.cinemaServiceV1.GetScreeningsInCity:output_type -> cinema_service.CityScreenings
+	36, // 39: cinema_service.cinemaServiceV1.GetHalls:output_type -> cinema_service.Halls
+	37, // 40: cinema_service.cinemaServiceV1.GetScreenings:output_type -> cinema_service.Screenings
+	38, // 41: cinema_service.cinemaServiceV1.GetHallConfiguration:output_type -> cinema_service.HallConfiguration
+	0,  // 42: cinema_service.cinemaServiceV1.UpdateScreeningStatus:output_type -> google.protobuf.Empty
+	39, // 43: cinema_service.cinemaServiceV1.ListEvents:output_type -> cinema_service.Events
+	37, // 44: cinema_service.cinemaServiceV1.GetEventScreenings:output_type -> cinema_service.Screenings
+	40, // 45: cinema_service.cinemaServiceV1.CreateScreening:output_type -> cinema_service.CreateScreeningResponse
+	41, // 46: cinema_service.cinemaServiceV1.CreateHallMaintenanceWindow:output_type -> cinema_service.HallMaintenanceWindow
+	42, // 47: cinema_service.cinemaServiceV1.ListHallMaintenanceWindows:output_type -> cinema_service.HallMaintenanceWindows
+	43, // 48: cinema_service.cinemaServiceV1.UploadCinemaPhoto:output_type -> cinema_service.UploadCinemaPhotoResponse
+	44, // 49: cinema_service.cinemaServiceV1.ListChains:output_type -> cinema_service.Chains
+	45, // 50: cinema_service.cinemaServiceV1.Search:output_type -> cinema_service.SearchResponse
+	46, // 51: cinema_service.cinemaServiceV1.AutocompleteCities:output_type -> cinema_service.CitiesSuggestions
+	47, // 52: cinema_service.cinemaServiceV1.GetCinemasInBounds:output_type -> cinema_service.CinemasInBounds
+	48, // 53: cinema_service.cinemaServiceV1.ResolveCity:output_type -> cinema_service.ResolveCityResponse
+	49, // 54: cinema_service.cinemaServiceV1.ListCountries:output_type -> cinema_service.Countries
+	50, // 55: cinema_service.cinemaServiceV1.ListRegions:output_type -> cinema_service.Regions
+	30, // 56: cinema_service.cinemaServiceV1.GetRegionCities:output_type -> cinema_service.Cities
+	51, // 57: cinema_service.cinemaServiceV1.GetNowShowing:output_type -> cinema_service.CityMovies
+	51, // 58: cinema_service.cinemaServiceV1.GetComingSoon:output_type -> cinema_service.CityMovies
+	52, // 59: cinema_service.cinemaServiceV1.GetCinemaUpcoming:output_type -> cinema_service.MoviesUpcomingScreenings
+	53, // 60: cinema_service.cinemaServiceV1.GetAlternatives:output_type -> cinema_service.AlternativeScreenings
+	54, // 61: cinema_service.cinemaServiceV1.GetCinemasByIds:output_type -> cinema_service.CinemasByIds
+	55, // 62: cinema_service.cinemaServiceV1.GetScreeningsByIds:output_type -> cinema_service.ScreeningsByIds
+	36, // 63: cinema_service.cinemaServiceV1.GetCinemaHalls:output_type -> cinema_service.Halls
+	32, // [32:64] is the sub-list for method output_type
+	0,  // [0:32] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -39,10 +39,60 @@ type CinemaServiceV1Client interface {
 	GetScreeningsInCity(ctx context.Context, in *GetScreeningsInCityRequest, opts ...grpc.CallOption) (*CityScreenings, error)
 	// Returns info for the halls with specified ids (without configuration).
 	GetHalls(ctx context.Context, in *GetHallsRequest, opts ...grpc.CallOption) (*Halls, error)
-	// Returns all screenings for a movie in a specific cinema.
+	//Returns all screenings for a movie in a specific cinema.
 	GetScreenings(ctx context.Context, in *GetScreeningsRequest, opts ...grpc.CallOption) (*Screenings, error)
 	// Returns the configuration of the hall.
 	GetHallConfiguration(ctx context.Context, in *GetHallConfigurationRequest, opts ...grpc.CallOption) (*HallConfiguration, error)
+	// Changes the screening status and saves the change into the statuses history.
+	// Not exposed over the public REST gateway.
+	UpdateScreeningStatus(ctx context.Context, in *UpdateScreeningStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns not finished events (festivals, marathons, premieres) with screenings in the city.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	// Returns screenings of the event.
+	GetEventScreenings(ctx context.Context, in *GetEventScreeningsRequest, opts ...grpc.CallOption) (*Screenings, error)
+	// Schedules the screening, the screening must be within the cinema opening hours.
+	// Not exposed over the public REST gateway.
+	CreateScreening(ctx context.Context, in *CreateScreeningRequest, opts ...grpc.CallOption) (*CreateScreeningResponse, error)
+	// Creates the hall maintenance window, returns the window with the screenings that need a reschedule.
+	// Not exposed over the public REST gateway.
+	CreateHallMaintenanceWindow(ctx context.Context, in *CreateHallMaintenanceWindowRequest, opts ...grpc.CallOption) (*HallMaintenanceWindow, error)
+	// Returns current and upcoming maintenance windows of the hall.
+	// Not exposed over the public REST gateway.
+	ListHallMaintenanceWindows(ctx context.Context, in *ListHallMaintenanceWindowsRequest, opts ...grpc.CallOption) (*HallMaintenanceWindows, error)
+	// Uploads the cinema photo and returns its url.
+	// Not exposed over the public REST gateway.
+	UploadCinemaPhoto(ctx context.Context, in *UploadCinemaPhotoRequest, opts ...grpc.CallOption) (*UploadCinemaPhotoResponse, error)
+	// Returns cinema chains, that have cinemas in the city.
+	ListChains(ctx context.Context, in *ListChainsRequest, opts ...grpc.CallOption) (*Chains, error)
+	// Searches cities and cinemas by name or address, results ordered by relevance.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Returns cities, which name starts with the prefix, cities with cinemas go first.
+	AutocompleteCities(ctx context.Context, in *AutocompleteCitiesRequest, opts ...grpc.CallOption) (*CitiesSuggestions, error)
+	// Returns cinemas in the map bounds, on the small zoom levels cinemas are grouped into clusters.
+	GetCinemasInBounds(ctx context.Context, in *GetCinemasInBoundsRequest, opts ...grpc.CallOption) (*CinemasInBounds, error)
+	// Returns the city containing the point, if the point is not inside any city boundary,
+	// returns the city of the nearest cinema.
+	ResolveCity(ctx context.Context, in *ResolveCityRequest, opts ...grpc.CallOption) (*ResolveCityResponse, error)
+	// Returns all countries.
+	ListCountries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Countries, error)
+	// Returns regions of the country.
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*Regions, error)
+	// Returns cities of the region where there are cinemas.
+	GetRegionCities(ctx context.Context, in *GetRegionCitiesRequest, opts ...grpc.CallOption) (*Cities, error)
+	// Returns movies with the screenings in the city in the next days.
+	GetNowShowing(ctx context.Context, in *GetCityMoviesRequest, opts ...grpc.CallOption) (*CityMovies, error)
+	// Returns movies which first screening in the city is beyond the now showing horizon.
+	GetComingSoon(ctx context.Context, in *GetCityMoviesRequest, opts ...grpc.CallOption) (*CityMovies, error)
+	// Returns movies of the cinema with their next screenings.
+	GetCinemaUpcoming(ctx context.Context, in *GetCinemaUpcomingRequest, opts ...grpc.CallOption) (*MoviesUpcomingScreenings, error)
+	// Returns purchasable screenings of the same movie in the same cinema and the nearest cinemas of the city.
+	GetAlternatives(ctx context.Context, in *GetAlternativesRequest, opts ...grpc.CallOption) (*AlternativeScreenings, error)
+	// Returns cinemas with specified ids, ids of the not found cinemas are returned separately.
+	GetCinemasByIds(ctx context.Context, in *GetCinemasByIdsRequest, opts ...grpc.CallOption) (*CinemasByIds, error)
+	// Returns screenings with specified ids, ids of the not found screenings are returned separately.
+	GetScreeningsByIds(ctx context.Context, in *GetScreeningsByIdsRequest, opts ...grpc.CallOption) (*ScreeningsByIds, error)
+	// Returns all halls of the cinema (without configuration).
+	GetCinemaHalls(ctx context.Context, in *GetCinemaHalls, opts ...grpc.CallOption) (*Halls, error)
 }
 
 type cinemaServiceV1Client struct {
@@ -143,6 +193,204 @@ func (c *cinemaServiceV1Client) GetHallConfiguration(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *cinemaServiceV1Client) UpdateScreeningStatus(ctx context.Context, in *UpdateScreeningStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/UpdateScreeningStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetEventScreenings(ctx context.Context, in *GetEventScreeningsRequest, opts ...grpc.CallOption) (*Screenings, error) {
+	out := new(Screenings)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetEventScreenings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) CreateScreening(ctx context.Context, in *CreateScreeningRequest, opts ...grpc.CallOption) (*CreateScreeningResponse, error) {
+	out := new(CreateScreeningResponse)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/CreateScreening", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) CreateHallMaintenanceWindow(ctx context.Context, in *CreateHallMaintenanceWindowRequest, opts ...grpc.CallOption) (*HallMaintenanceWindow, error) {
+	out := new(HallMaintenanceWindow)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/CreateHallMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ListHallMaintenanceWindows(ctx context.Context, in *ListHallMaintenanceWindowsRequest, opts ...grpc.CallOption) (*HallMaintenanceWindows, error) {
+	out := new(HallMaintenanceWindows)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ListHallMaintenanceWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) UploadCinemaPhoto(ctx context.Context, in *UploadCinemaPhotoRequest, opts ...grpc.CallOption) (*UploadCinemaPhotoResponse, error) {
+	out := new(UploadCinemaPhotoResponse)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/UploadCinemaPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ListChains(ctx context.Context, in *ListChainsRequest, opts ...grpc.CallOption) (*Chains, error) {
+	out := new(Chains)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ListChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) AutocompleteCities(ctx context.Context, in *AutocompleteCitiesRequest, opts ...grpc.CallOption) (*CitiesSuggestions, error) {
+	out := new(CitiesSuggestions)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/AutocompleteCities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetCinemasInBounds(ctx context.Context, in *GetCinemasInBoundsRequest, opts ...grpc.CallOption) (*CinemasInBounds, error) {
+	out := new(CinemasInBounds)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetCinemasInBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ResolveCity(ctx context.Context, in *ResolveCityRequest, opts ...grpc.CallOption) (*ResolveCityResponse, error) {
+	out := new(ResolveCityResponse)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ResolveCity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ListCountries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Countries, error) {
+	out := new(Countries)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ListCountries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*Regions, error) {
+	out := new(Regions)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/ListRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetRegionCities(ctx context.Context, in *GetRegionCitiesRequest, opts ...grpc.CallOption) (*Cities, error) {
+	out := new(Cities)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetRegionCities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetNowShowing(ctx context.Context, in *GetCityMoviesRequest, opts ...grpc.CallOption) (*CityMovies, error) {
+	out := new(CityMovies)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetNowShowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetComingSoon(ctx context.Context, in *GetCityMoviesRequest, opts ...grpc.CallOption) (*CityMovies, error) {
+	out := new(CityMovies)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetComingSoon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetCinemaUpcoming(ctx context.Context, in *GetCinemaUpcomingRequest, opts ...grpc.CallOption) (*MoviesUpcomingScreenings, error) {
+	out := new(MoviesUpcomingScreenings)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetCinemaUpcoming", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetAlternatives(ctx context.Context, in *GetAlternativesRequest, opts ...grpc.CallOption) (*AlternativeScreenings, error) {
+	out := new(AlternativeScreenings)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetAlternatives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetCinemasByIds(ctx context.Context, in *GetCinemasByIdsRequest, opts ...grpc.CallOption) (*CinemasByIds, error) {
+	out := new(CinemasByIds)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetCinemasByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetScreeningsByIds(ctx context.Context, in *GetScreeningsByIdsRequest, opts ...grpc.CallOption) (*ScreeningsByIds, error) {
+	out := new(ScreeningsByIds)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetScreeningsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cinemaServiceV1Client) GetCinemaHalls(ctx context.Context, in *GetCinemaHalls, opts ...grpc.CallOption) (*Halls, error) {
+	out := new(Halls)
+	err := c.cc.Invoke(ctx, "/cinema_service.cinemaServiceV1/GetCinemaHalls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CinemaServiceV1Server is the server API for CinemaServiceV1 service.
 // All implementations must embed UnimplementedCinemaServiceV1Server
 // for forward compatibility
@@ -163,10 +411,60 @@ type CinemaServiceV1Server interface {
 	GetScreeningsInCity(context.Context, *GetScreeningsInCityRequest) (*CityScreenings, error)
 	// Returns info for the halls with specified ids (without configuration).
 	GetHalls(context.Context, *GetHallsRequest) (*Halls, error)
-	// Returns all screenings for a movie in a specific cinema.
+	//Returns all screenings for a movie in a specific cinema.
 	GetScreenings(context.Context, *GetScreeningsRequest) (*Screenings, error)
 	// Returns the configuration of the hall.
 	GetHallConfiguration(context.Context, *GetHallConfigurationRequest) (*HallConfiguration, error)
+	// Changes the screening status and saves the change into the statuses history.
+	// Not exposed over the public REST gateway.
+	UpdateScreeningStatus(context.Context, *UpdateScreeningStatusRequest) (*emptypb.Empty, error)
+	// Returns not finished events (festivals, marathons, premieres) with screenings in the city.
+	ListEvents(context.Context, *ListEventsRequest) (*Events, error)
+	// Returns screenings of the event.
+	GetEventScreenings(context.Context, *GetEventScreeningsRequest) (*Screenings, error)
+	// Schedules the screening, the screening must be within the cinema opening hours.
+	// Not exposed over the public REST gateway.
+	CreateScreening(context.Context, *CreateScreeningRequest) (*CreateScreeningResponse, error)
+	// Creates the hall maintenance window, returns the window with the screenings that need a reschedule.
+	// Not exposed over the public REST gateway.
+	CreateHallMaintenanceWindow(context.Context, *CreateHallMaintenanceWindowRequest) (*HallMaintenanceWindow, error)
+	// Returns current and upcoming maintenance windows of the hall.
+	// Not exposed over the public REST gateway.
+	ListHallMaintenanceWindows(context.Context, *ListHallMaintenanceWindowsRequest) (*HallMaintenanceWindows, error)
+	// Uploads the cinema photo and returns its url.
+	// Not exposed over the public REST gateway.
+	UploadCinemaPhoto(context.Context, *UploadCinemaPhotoRequest) (*UploadCinemaPhotoResponse, error)
+	// Returns cinema chains, that have cinemas in the city.
+	ListChains(context.Context, *ListChainsRequest) (*Chains, error)
+	// Searches cities and cinemas by name or address, results ordered by relevance.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Returns cities, which name starts with the prefix, cities with cinemas go first.
+	AutocompleteCities(context.Context, *AutocompleteCitiesRequest) (*CitiesSuggestions, error)
+	// Returns cinemas in the map bounds, on the small zoom levels cinemas are grouped into clusters.
+	GetCinemasInBounds(context.Context, *GetCinemasInBoundsRequest) (*CinemasInBounds, error)
+	// Returns the city containing the point, if the point is not inside any city boundary,
+	// returns the city of the nearest cinema.
+	ResolveCity(context.Context, *ResolveCityRequest) (*ResolveCityResponse, error)
+	// Returns all countries.
+	ListCountries(context.Context, *emptypb.Empty) (*Countries, error)
+	// Returns regions of the country.
+	ListRegions(context.Context, *ListRegionsRequest) (*Regions, error)
+	// Returns cities of the region where there are cinemas.
+	GetRegionCities(context.Context, *GetRegionCitiesRequest) (*Cities, error)
+	// Returns movies with the screenings in the city in the next days.
+	GetNowShowing(context.Context, *GetCityMoviesRequest) (*CityMovies, error)
+	// Returns movies which first screening in the city is beyond the now showing horizon.
+	GetComingSoon(context.Context, *GetCityMoviesRequest) (*CityMovies, error)
+	// Returns movies of the cinema with their next screenings.
+	GetCinemaUpcoming(context.Context, *GetCinemaUpcomingRequest) (*MoviesUpcomingScreenings, error)
+	// Returns purchasable screenings of the same movie in the same cinema and the nearest cinemas of the city.
+	GetAlternatives(context.Context, *GetAlternativesRequest) (*AlternativeScreenings, error)
+	// Returns cinemas with specified ids, ids of the not found cinemas are returned separately.
+	GetCinemasByIds(context.Context, *GetCinemasByIdsRequest) (*CinemasByIds, error)
+	// Returns screenings with specified ids, ids of the not found screenings are returned separately.
+	GetScreeningsByIds(context.Context, *GetScreeningsByIdsRequest) (*ScreeningsByIds, error)
+	// Returns all halls of the cinema (without configuration).
+	GetCinemaHalls(context.Context, *GetCinemaHalls) (*Halls, error)
 	mustEmbedUnimplementedCinemaServiceV1Server()
 }
 
@@ -204,6 +502,72 @@ func (UnimplementedCinemaServiceV1Server) GetScreenings(context.Context, *GetScr
 func (UnimplementedCinemaServiceV1Server) GetHallConfiguration(context.Context, *GetHallConfigurationRequest) (*HallConfiguration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHallConfiguration not implemented")
 }
+func (UnimplementedCinemaServiceV1Server) UpdateScreeningStatus(context.Context, *UpdateScreeningStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScreeningStatus not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ListEvents(context.Context, *ListEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetEventScreenings(context.Context, *GetEventScreeningsRequest) (*Screenings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventScreenings not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) CreateScreening(context.Context, *CreateScreeningRequest) (*CreateScreeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScreening not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) CreateHallMaintenanceWindow(context.Context, *CreateHallMaintenanceWindowRequest) (*HallMaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHallMaintenanceWindow not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ListHallMaintenanceWindows(context.Context, *ListHallMaintenanceWindowsRequest) (*HallMaintenanceWindows, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHallMaintenanceWindows not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) UploadCinemaPhoto(context.Context, *UploadCinemaPhotoRequest) (*UploadCinemaPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadCinemaPhoto not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ListChains(context.Context, *ListChainsRequest) (*Chains, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChains not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) AutocompleteCities(context.Context, *AutocompleteCitiesRequest) (*CitiesSuggestions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteCities not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetCinemasInBounds(context.Context, *GetCinemasInBoundsRequest) (*CinemasInBounds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCinemasInBounds not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ResolveCity(context.Context, *ResolveCityRequest) (*ResolveCityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCity not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ListCountries(context.Context, *emptypb.Empty) (*Countries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCountries not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) ListRegions(context.Context, *ListRegionsRequest) (*Regions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetRegionCities(context.Context, *GetRegionCitiesRequest) (*Cities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegionCities not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetNowShowing(context.Context, *GetCityMoviesRequest) (*CityMovies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNowShowing not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetComingSoon(context.Context, *GetCityMoviesRequest) (*CityMovies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComingSoon not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetCinemaUpcoming(context.Context, *GetCinemaUpcomingRequest) (*MoviesUpcomingScreenings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCinemaUpcoming not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetAlternatives(context.Context, *GetAlternativesRequest) (*AlternativeScreenings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlternatives not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetCinemasByIds(context.Context, *GetCinemasByIdsRequest) (*CinemasByIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCinemasByIds not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetScreeningsByIds(context.Context, *GetScreeningsByIdsRequest) (*ScreeningsByIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScreeningsByIds not implemented")
+}
+func (UnimplementedCinemaServiceV1Server) GetCinemaHalls(context.Context, *GetCinemaHalls) (*Halls, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCinemaHalls not implemented")
+}
 func (UnimplementedCinemaServiceV1Server) mustEmbedUnimplementedCinemaServiceV1Server() {}
 
 // UnsafeCinemaServiceV1Server may be embedded to opt out of forward compatibility for this service.
@@ -397,6 +761,402 @@ func _CinemaServiceV1_GetHallConfiguration_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CinemaServiceV1_UpdateScreeningStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScreeningStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).UpdateScreeningStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/UpdateScreeningStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).UpdateScreeningStatus(ctx, req.(*UpdateScreeningStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetEventScreenings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventScreeningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetEventScreenings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetEventScreenings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetEventScreenings(ctx, req.(*GetEventScreeningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_CreateScreening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScreeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).CreateScreening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/CreateScreening",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).CreateScreening(ctx, req.(*CreateScreeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_CreateHallMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHallMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).CreateHallMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/CreateHallMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).CreateHallMaintenanceWindow(ctx, req.(*CreateHallMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ListHallMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHallMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ListHallMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ListHallMaintenanceWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ListHallMaintenanceWindows(ctx, req.(*ListHallMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_UploadCinemaPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCinemaPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).UploadCinemaPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/UploadCinemaPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).UploadCinemaPhoto(ctx, req.(*UploadCinemaPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ListChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ListChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ListChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ListChains(ctx, req.(*ListChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_AutocompleteCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).AutocompleteCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/AutocompleteCities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).AutocompleteCities(ctx, req.(*AutocompleteCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetCinemasInBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCinemasInBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetCinemasInBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetCinemasInBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetCinemasInBounds(ctx, req.(*GetCinemasInBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ResolveCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ResolveCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ResolveCity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ResolveCity(ctx, req.(*ResolveCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ListCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ListCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ListCountries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ListCountries(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/ListRegions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetRegionCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetRegionCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetRegionCities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetRegionCities(ctx, req.(*GetRegionCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetNowShowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetNowShowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetNowShowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetNowShowing(ctx, req.(*GetCityMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetComingSoon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetComingSoon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetComingSoon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetComingSoon(ctx, req.(*GetCityMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetCinemaUpcoming_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCinemaUpcomingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetCinemaUpcoming(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetCinemaUpcoming",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetCinemaUpcoming(ctx, req.(*GetCinemaUpcomingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetAlternatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlternativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetAlternatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetAlternatives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetAlternatives(ctx, req.(*GetAlternativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetCinemasByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCinemasByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetCinemasByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetCinemasByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetCinemasByIds(ctx, req.(*GetCinemasByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetScreeningsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScreeningsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetScreeningsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetScreeningsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetScreeningsByIds(ctx, req.(*GetScreeningsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CinemaServiceV1_GetCinemaHalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCinemaHalls)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CinemaServiceV1Server).GetCinemaHalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cinema_service.cinemaServiceV1/GetCinemaHalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CinemaServiceV1Server).GetCinemaHalls(ctx, req.(*GetCinemaHalls))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CinemaServiceV1_ServiceDesc is the grpc.ServiceDesc for CinemaServiceV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -444,6 +1204,94 @@ var CinemaServiceV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHallConfiguration",
 			Handler:    _CinemaServiceV1_GetHallConfiguration_Handler,
 		},
+		{
+			MethodName: "UpdateScreeningStatus",
+			Handler:    _CinemaServiceV1_UpdateScreeningStatus_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _CinemaServiceV1_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetEventScreenings",
+			Handler:    _CinemaServiceV1_GetEventScreenings_Handler,
+		},
+		{
+			MethodName: "CreateScreening",
+			Handler:    _CinemaServiceV1_CreateScreening_Handler,
+		},
+		{
+			MethodName: "CreateHallMaintenanceWindow",
+			Handler:    _CinemaServiceV1_CreateHallMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListHallMaintenanceWindows",
+			Handler:    _CinemaServiceV1_ListHallMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "UploadCinemaPhoto",
+			Handler:    _CinemaServiceV1_UploadCinemaPhoto_Handler,
+		},
+		{
+			MethodName: "ListChains",
+			Handler:    _CinemaServiceV1_ListChains_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _CinemaServiceV1_Search_Handler,
+		},
+		{
+			MethodName: "AutocompleteCities",
+			Handler:    _CinemaServiceV1_AutocompleteCities_Handler,
+		},
+		{
+			MethodName: "GetCinemasInBounds",
+			Handler:    _CinemaServiceV1_GetCinemasInBounds_Handler,
+		},
+		{
+			MethodName: "ResolveCity",
+			Handler:    _CinemaServiceV1_ResolveCity_Handler,
+		},
+		{
+			MethodName: "ListCountries",
+			Handler:    _CinemaServiceV1_ListCountries_Handler,
+		},
+		{
+			MethodName: "ListRegions",
+			Handler:    _CinemaServiceV1_ListRegions_Handler,
+		},
+		{
+			MethodName: "GetRegionCities",
+			Handler:    _CinemaServiceV1_GetRegionCities_Handler,
+		},
+		{
+			MethodName: "GetNowShowing",
+			Handler:    _CinemaServiceV1_GetNowShowing_Handler,
+		},
+		{
+			MethodName: "GetComingSoon",
+			Handler:    _CinemaServiceV1_GetComingSoon_Handler,
+		},
+		{
+			MethodName: "GetCinemaUpcoming",
+			Handler:    _CinemaServiceV1_GetCinemaUpcoming_Handler,
+		},
+		{
+			MethodName: "GetAlternatives",
+			Handler:    _CinemaServiceV1_GetAlternatives_Handler,
+		},
+		{
+			MethodName: "GetCinemasByIds",
+			Handler:    _CinemaServiceV1_GetCinemasByIds_Handler,
+		},
+		{
+			MethodName: "GetScreeningsByIds",
+			Handler:    _CinemaServiceV1_GetScreeningsByIds_Handler,
+		},
+		{
+			MethodName: "GetCinemaHalls",
+			Handler:    _CinemaServiceV1_GetCinemaHalls_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cinema_service_v1.proto",
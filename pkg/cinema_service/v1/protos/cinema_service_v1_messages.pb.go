@@ -21,6 +21,204 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ScreeningStatus int32
+
+const (
+	ScreeningStatus_SCREENING_STATUS_SCHEDULED ScreeningStatus = 0
+	ScreeningStatus_SCREENING_STATUS_CANCELLED ScreeningStatus = 1
+	ScreeningStatus_SCREENING_STATUS_POSTPONED ScreeningStatus = 2
+	// screening is moved to another time or hall, see replacement_screening_id
+	ScreeningStatus_SCREENING_STATUS_MOVED    ScreeningStatus = 3
+	ScreeningStatus_SCREENING_STATUS_SOLD_OUT ScreeningStatus = 4
+)
+
+// Enum value maps for ScreeningStatus.
+var (
+	ScreeningStatus_name = map[int32]string{
+		0: "SCREENING_STATUS_SCHEDULED",
+		1: "SCREENING_STATUS_CANCELLED",
+		2: "SCREENING_STATUS_POSTPONED",
+		3: "SCREENING_STATUS_MOVED",
+		4: "SCREENING_STATUS_SOLD_OUT",
+	}
+	ScreeningStatus_value = map[string]int32{
+		"SCREENING_STATUS_SCHEDULED": 0,
+		"SCREENING_STATUS_CANCELLED": 1,
+		"SCREENING_STATUS_POSTPONED": 2,
+		"SCREENING_STATUS_MOVED":     3,
+		"SCREENING_STATUS_SOLD_OUT":  4,
+	}
+)
+
+func (x ScreeningStatus) Enum() *ScreeningStatus {
+	p := new(ScreeningStatus)
+	*p = x
+	return p
+}
+
+func (x ScreeningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreeningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_service_v1_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ScreeningStatus) Type() protoreflect.EnumType {
+	return &file_cinema_service_v1_messages_proto_enumTypes[0]
+}
+
+func (x ScreeningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreeningStatus.Descriptor instead.
+func (ScreeningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{0}
+}
+
+type HallAvailability int32
+
+const (
+	HallAvailability_HALL_AVAILABILITY_AVAILABLE         HallAvailability = 0
+	HallAvailability_HALL_AVAILABILITY_UNDER_MAINTENANCE HallAvailability = 1
+)
+
+// Enum value maps for HallAvailability.
+var (
+	HallAvailability_name = map[int32]string{
+		0: "HALL_AVAILABILITY_AVAILABLE",
+		1: "HALL_AVAILABILITY_UNDER_MAINTENANCE",
+	}
+	HallAvailability_value = map[string]int32{
+		"HALL_AVAILABILITY_AVAILABLE":         0,
+		"HALL_AVAILABILITY_UNDER_MAINTENANCE": 1,
+	}
+)
+
+func (x HallAvailability) Enum() *HallAvailability {
+	p := new(HallAvailability)
+	*p = x
+	return p
+}
+
+func (x HallAvailability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HallAvailability) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_service_v1_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (HallAvailability) Type() protoreflect.EnumType {
+	return &file_cinema_service_v1_messages_proto_enumTypes[1]
+}
+
+func (x HallAvailability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HallAvailability.Descriptor instead.
+func (HallAvailability) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{1}
+}
+
+type PlaceAvailability int32
+
+const (
+	// availability is not requested or the seats availability provider is unavailable
+	PlaceAvailability_PLACE_AVAILABILITY_UNKNOWN  PlaceAvailability = 0
+	PlaceAvailability_PLACE_AVAILABILITY_FREE     PlaceAvailability = 1
+	PlaceAvailability_PLACE_AVAILABILITY_OCCUPIED PlaceAvailability = 2
+)
+
+// Enum value maps for PlaceAvailability.
+var (
+	PlaceAvailability_name = map[int32]string{
+		0: "PLACE_AVAILABILITY_UNKNOWN",
+		1: "PLACE_AVAILABILITY_FREE",
+		2: "PLACE_AVAILABILITY_OCCUPIED",
+	}
+	PlaceAvailability_value = map[string]int32{
+		"PLACE_AVAILABILITY_UNKNOWN":  0,
+		"PLACE_AVAILABILITY_FREE":     1,
+		"PLACE_AVAILABILITY_OCCUPIED": 2,
+	}
+)
+
+func (x PlaceAvailability) Enum() *PlaceAvailability {
+	p := new(PlaceAvailability)
+	*p = x
+	return p
+}
+
+func (x PlaceAvailability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaceAvailability) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_service_v1_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (PlaceAvailability) Type() protoreflect.EnumType {
+	return &file_cinema_service_v1_messages_proto_enumTypes[2]
+}
+
+func (x PlaceAvailability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaceAvailability.Descriptor instead.
+func (PlaceAvailability) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{2}
+}
+
+type SearchResultType int32
+
+const (
+	SearchResultType_SEARCH_RESULT_TYPE_CITY   SearchResultType = 0
+	SearchResultType_SEARCH_RESULT_TYPE_CINEMA SearchResultType = 1
+)
+
+// Enum value maps for SearchResultType.
+var (
+	SearchResultType_name = map[int32]string{
+		0: "SEARCH_RESULT_TYPE_CITY",
+		1: "SEARCH_RESULT_TYPE_CINEMA",
+	}
+	SearchResultType_value = map[string]int32{
+		"SEARCH_RESULT_TYPE_CITY":   0,
+		"SEARCH_RESULT_TYPE_CINEMA": 1,
+	}
+)
+
+func (x SearchResultType) Enum() *SearchResultType {
+	p := new(SearchResultType)
+	*p = x
+	return p
+}
+
+func (x SearchResultType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchResultType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cinema_service_v1_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (SearchResultType) Type() protoreflect.EnumType {
+	return &file_cinema_service_v1_messages_proto_enumTypes[3]
+}
+
+func (x SearchResultType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchResultType.Descriptor instead.
+func (SearchResultType) EnumDescriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{3}
+}
+
 type Timestamp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -78,6 +276,28 @@ type GetMoviesScreeningsRequest struct {
 	CinemaID    int32      `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
 	StartPeriod *Timestamp `protobuf:"bytes,2,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
 	EndPeriod   *Timestamp `protobuf:"bytes,3,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+	// halls capabilities names, screenings halls must have all specified capabilities, for multiple values use ',' as separator
+	HallsCapabilities *string `protobuf:"bytes,4,opt,name=hallsCapabilities,json=halls_capabilities,proto3,oneof" json:"hallsCapabilities,omitempty"`
+	// ISO 639-1 code of the spoken language, for example en
+	SpokenLanguage *string `protobuf:"bytes,5,opt,name=spokenLanguage,json=spoken_language,proto3,oneof" json:"spokenLanguage,omitempty"`
+	// ISO 639-1 code of the subtitles language
+	SubtitlesLanguage *string `protobuf:"bytes,6,opt,name=subtitlesLanguage,json=subtitles_language,proto3,oneof" json:"subtitlesLanguage,omitempty"`
+	// if true, returns only screenings in the original language
+	OriginalLanguage bool `protobuf:"varint,7,opt,name=originalLanguage,json=original_language,proto3" json:"originalLanguage,omitempty"`
+	// if true, returns only screenings with audio description
+	AudioDescription bool `protobuf:"varint,8,opt,name=audioDescription,json=audio_description,proto3" json:"audioDescription,omitempty"`
+	// if true, returns only screenings with closed captions
+	ClosedCaptions bool `protobuf:"varint,9,opt,name=closedCaptions,json=closed_captions,proto3" json:"closedCaptions,omitempty"`
+	// if true, returns only relaxed (sensory-friendly) screenings
+	Relaxed bool `protobuf:"varint,10,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
+	// allowed age ratings, for multiple values use ',' as separator
+	AgeRatings *string `protobuf:"bytes,11,opt,name=ageRatings,json=age_ratings,proto3,oneof" json:"ageRatings,omitempty"`
+	// if true, cancelled and moved screenings will be returned too
+	IncludeCancelled bool `protobuf:"varint,12,opt,name=includeCancelled,json=include_cancelled,proto3" json:"includeCancelled,omitempty"`
+	// if true, returns only screenings with tickets on sale at the moment
+	PurchasableOnly bool `protobuf:"varint,13,opt,name=purchasableOnly,json=purchasable_only,proto3" json:"purchasableOnly,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,14,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
 }
 
 func (x *GetMoviesScreeningsRequest) Reset() {
@@ -133,6 +353,83 @@ func (x *GetMoviesScreeningsRequest) GetEndPeriod() *Timestamp {
 	return nil
 }
 
+func (x *GetMoviesScreeningsRequest) GetHallsCapabilities() string {
+	if x != nil && x.HallsCapabilities != nil {
+		return *x.HallsCapabilities
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsRequest) GetSpokenLanguage() string {
+	if x != nil && x.SpokenLanguage != nil {
+		return *x.SpokenLanguage
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsRequest) GetSubtitlesLanguage() string {
+	if x != nil && x.SubtitlesLanguage != nil {
+		return *x.SubtitlesLanguage
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsRequest) GetOriginalLanguage() bool {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetAudioDescription() bool {
+	if x != nil {
+		return x.AudioDescription
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetClosedCaptions() bool {
+	if x != nil {
+		return x.ClosedCaptions
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetRelaxed() bool {
+	if x != nil {
+		return x.Relaxed
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetAgeRatings() string {
+	if x != nil && x.AgeRatings != nil {
+		return *x.AgeRatings
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetPurchasableOnly() bool {
+	if x != nil {
+		return x.PurchasableOnly
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
 type GetMoviesScreeningsInCitiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -142,6 +439,32 @@ type GetMoviesScreeningsInCitiesRequest struct {
 	CitiesIds   *string    `protobuf:"bytes,1,opt,name=citiesIds,json=cities_ids,proto3,oneof" json:"citiesIds,omitempty"`
 	StartPeriod *Timestamp `protobuf:"bytes,2,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
 	EndPeriod   *Timestamp `protobuf:"bytes,3,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+	// halls capabilities names, screenings halls must have all specified capabilities, for multiple values use ',' as separator
+	HallsCapabilities *string `protobuf:"bytes,4,opt,name=hallsCapabilities,json=halls_capabilities,proto3,oneof" json:"hallsCapabilities,omitempty"`
+	// ISO 639-1 code of the spoken language, for example en
+	SpokenLanguage *string `protobuf:"bytes,5,opt,name=spokenLanguage,json=spoken_language,proto3,oneof" json:"spokenLanguage,omitempty"`
+	// ISO 639-1 code of the subtitles language
+	SubtitlesLanguage *string `protobuf:"bytes,6,opt,name=subtitlesLanguage,json=subtitles_language,proto3,oneof" json:"subtitlesLanguage,omitempty"`
+	// if true, returns only screenings in the original language
+	OriginalLanguage bool `protobuf:"varint,7,opt,name=originalLanguage,json=original_language,proto3" json:"originalLanguage,omitempty"`
+	// if true, returns only screenings with audio description
+	AudioDescription bool `protobuf:"varint,8,opt,name=audioDescription,json=audio_description,proto3" json:"audioDescription,omitempty"`
+	// if true, returns only screenings with closed captions
+	ClosedCaptions bool `protobuf:"varint,9,opt,name=closedCaptions,json=closed_captions,proto3" json:"closedCaptions,omitempty"`
+	// if true, returns only relaxed (sensory-friendly) screenings
+	Relaxed bool `protobuf:"varint,10,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
+	// allowed age ratings, for multiple values use ',' as separator
+	AgeRatings *string `protobuf:"bytes,11,opt,name=ageRatings,json=age_ratings,proto3,oneof" json:"ageRatings,omitempty"`
+	// if true, cancelled and moved screenings will be returned too
+	IncludeCancelled bool `protobuf:"varint,12,opt,name=includeCancelled,json=include_cancelled,proto3" json:"includeCancelled,omitempty"`
+	// if true, returns only screenings with tickets on sale at the moment
+	PurchasableOnly bool `protobuf:"varint,13,opt,name=purchasableOnly,json=purchasable_only,proto3" json:"purchasableOnly,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,14,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+	// screenings in the cities of the specified regions are returned too, for multiple values use ',' as separator
+	RegionsIds *string `protobuf:"bytes,15,opt,name=regionsIds,json=regions_ids,proto3,oneof" json:"regionsIds,omitempty"`
+	// if true, the screenings stats are aggregated for each movie
+	IncludeStats bool `protobuf:"varint,16,opt,name=includeStats,json=include_stats,proto3" json:"includeStats,omitempty"`
 }
 
 func (x *GetMoviesScreeningsInCitiesRequest) Reset() {
@@ -197,6 +520,97 @@ func (x *GetMoviesScreeningsInCitiesRequest) GetEndPeriod() *Timestamp {
 	return nil
 }
 
+func (x *GetMoviesScreeningsInCitiesRequest) GetHallsCapabilities() string {
+	if x != nil && x.HallsCapabilities != nil {
+		return *x.HallsCapabilities
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetSpokenLanguage() string {
+	if x != nil && x.SpokenLanguage != nil {
+		return *x.SpokenLanguage
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetSubtitlesLanguage() string {
+	if x != nil && x.SubtitlesLanguage != nil {
+		return *x.SubtitlesLanguage
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetOriginalLanguage() bool {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetAudioDescription() bool {
+	if x != nil {
+		return x.AudioDescription
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetClosedCaptions() bool {
+	if x != nil {
+		return x.ClosedCaptions
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetRelaxed() bool {
+	if x != nil {
+		return x.Relaxed
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetAgeRatings() string {
+	if x != nil && x.AgeRatings != nil {
+		return *x.AgeRatings
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetPurchasableOnly() bool {
+	if x != nil {
+		return x.PurchasableOnly
+	}
+	return false
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetRegionsIds() string {
+	if x != nil && x.RegionsIds != nil {
+		return *x.RegionsIds
+	}
+	return ""
+}
+
+func (x *GetMoviesScreeningsInCitiesRequest) GetIncludeStats() bool {
+	if x != nil {
+		return x.IncludeStats
+	}
+	return false
+}
+
 type Price struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -252,7 +666,10 @@ type PreviewScreening struct {
 
 	MovieID         int32    `protobuf:"varint,1,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
 	ScreeningsTypes []string `protobuf:"bytes,2,rep,name=screeningsTypes,json=screenings_types,proto3" json:"screeningsTypes,omitempty"`
-	HallsTypes      []string `protobuf:"bytes,3,rep,name=hallsTypes,json=halls_types,proto3" json:"hallsTypes,omitempty"`
+	// halls capabilities names of the screenings, includes halls types
+	HallsTypes []string `protobuf:"bytes,3,rep,name=hallsTypes,json=halls_types,proto3" json:"hallsTypes,omitempty"`
+	// not empty only if include_stats is true
+	Stats *MovieScreeningsStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (x *PreviewScreening) Reset() {
@@ -308,17 +725,30 @@ func (x *PreviewScreening) GetHallsTypes() []string {
 	return nil
 }
 
-// Unique set of cinema screenings (unique by movie_id)
-type PreviewScreenings struct {
+func (x *PreviewScreening) GetStats() *MovieScreeningsStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type MovieScreeningsStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Screenings []*PreviewScreening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+	ScreeningsCount   uint32     `protobuf:"varint,1,opt,name=screeningsCount,json=screenings_count,proto3" json:"screeningsCount,omitempty"`
+	CinemasCount      uint32     `protobuf:"varint,2,opt,name=cinemasCount,json=cinemas_count,proto3" json:"cinemasCount,omitempty"`
+	EarliestStartTime *Timestamp `protobuf:"bytes,3,opt,name=earliestStartTime,json=earliest_start_time,proto3" json:"earliestStartTime,omitempty"`
+	LatestStartTime   *Timestamp `protobuf:"bytes,4,opt,name=latestStartTime,json=latest_start_time,proto3" json:"latestStartTime,omitempty"`
+	// empty if the screenings have no price
+	MinPrice  *Price  `protobuf:"bytes,5,opt,name=minPrice,json=min_price,proto3" json:"minPrice,omitempty"`
+	MaxPrice  *Price  `protobuf:"bytes,6,opt,name=maxPrice,json=max_price,proto3" json:"maxPrice,omitempty"`
+	CitiesIds []int32 `protobuf:"varint,7,rep,packed,name=citiesIds,json=cities_ids,proto3" json:"citiesIds,omitempty"`
 }
 
-func (x *PreviewScreenings) Reset() {
-	*x = PreviewScreenings{}
+func (x *MovieScreeningsStats) Reset() {
+	*x = MovieScreeningsStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cinema_service_v1_messages_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -326,13 +756,13 @@ func (x *PreviewScreenings) Reset() {
 	}
 }
 
-func (x *PreviewScreenings) String() string {
+func (x *MovieScreeningsStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreviewScreenings) ProtoMessage() {}
+func (*MovieScreeningsStats) ProtoMessage() {}
 
-func (x *PreviewScreenings) ProtoReflect() protoreflect.Message {
+func (x *MovieScreeningsStats) ProtoReflect() protoreflect.Message {
 	mi := &file_cinema_service_v1_messages_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -344,31 +774,71 @@ func (x *PreviewScreenings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreviewScreenings.ProtoReflect.Descriptor instead.
-func (*PreviewScreenings) Descriptor() ([]byte, []int) {
+// Deprecated: Use MovieScreeningsStats.ProtoReflect.Descriptor instead.
+func (*MovieScreeningsStats) Descriptor() ([]byte, []int) {
 	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PreviewScreenings) GetScreenings() []*PreviewScreening {
+func (x *MovieScreeningsStats) GetScreeningsCount() uint32 {
 	if x != nil {
-		return x.Screenings
+		return x.ScreeningsCount
+	}
+	return 0
+}
+
+func (x *MovieScreeningsStats) GetCinemasCount() uint32 {
+	if x != nil {
+		return x.CinemasCount
+	}
+	return 0
+}
+
+func (x *MovieScreeningsStats) GetEarliestStartTime() *Timestamp {
+	if x != nil {
+		return x.EarliestStartTime
 	}
 	return nil
 }
 
-type GetScreeningsRequest struct {
+func (x *MovieScreeningsStats) GetLatestStartTime() *Timestamp {
+	if x != nil {
+		return x.LatestStartTime
+	}
+	return nil
+}
+
+func (x *MovieScreeningsStats) GetMinPrice() *Price {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *MovieScreeningsStats) GetMaxPrice() *Price {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *MovieScreeningsStats) GetCitiesIds() []int32 {
+	if x != nil {
+		return x.CitiesIds
+	}
+	return nil
+}
+
+// Unique set of cinema screenings (unique by movie_id)
+type PreviewScreenings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CinemaID    int32      `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
-	MovieID     int32      `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
-	StartPeriod *Timestamp `protobuf:"bytes,3,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
-	EndPeriod   *Timestamp `protobuf:"bytes,4,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+	Screenings []*PreviewScreening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
 }
 
-func (x *GetScreeningsRequest) Reset() {
-	*x = GetScreeningsRequest{}
+func (x *PreviewScreenings) Reset() {
+	*x = PreviewScreenings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cinema_service_v1_messages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +846,13 @@ func (x *GetScreeningsRequest) Reset() {
 	}
 }
 
-func (x *GetScreeningsRequest) String() string {
+func (x *PreviewScreenings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScreeningsRequest) ProtoMessage() {}
+func (*PreviewScreenings) ProtoMessage() {}
 
-func (x *GetScreeningsRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviewScreenings) ProtoReflect() protoreflect.Message {
 	mi := &file_cinema_service_v1_messages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,54 +864,55 @@ func (x *GetScreeningsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScreeningsRequest.ProtoReflect.Descriptor instead.
-func (*GetScreeningsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewScreenings.ProtoReflect.Descriptor instead.
+func (*PreviewScreenings) Descriptor() ([]byte, []int) {
 	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetScreeningsRequest) GetCinemaID() int32 {
+func (x *PreviewScreenings) GetScreenings() []*PreviewScreening {
 	if x != nil {
-		return x.CinemaID
-	}
-	return 0
-}
-
-func (x *GetScreeningsRequest) GetMovieID() int32 {
-	if x != nil {
-		return x.MovieID
-	}
-	return 0
-}
-
-func (x *GetScreeningsRequest) GetStartPeriod() *Timestamp {
-	if x != nil {
-		return x.StartPeriod
-	}
-	return nil
-}
-
-func (x *GetScreeningsRequest) GetEndPeriod() *Timestamp {
-	if x != nil {
-		return x.EndPeriod
+		return x.Screenings
 	}
 	return nil
 }
 
-type Screening struct {
+type GetScreeningsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScreeningID   int64      `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
-	MovieID       int32      `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
-	ScreeningType string     `protobuf:"bytes,3,opt,name=screeningType,json=screening_type,proto3" json:"screeningType,omitempty"`
-	StartTime     *Timestamp `protobuf:"bytes,4,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
-	HallID        int32      `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
-	TicketPrice   *Price     `protobuf:"bytes,6,opt,name=ticketPrice,json=ticket_price,proto3" json:"ticketPrice,omitempty"`
+	CinemaID    int32      `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	MovieID     int32      `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	StartPeriod *Timestamp `protobuf:"bytes,3,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
+	EndPeriod   *Timestamp `protobuf:"bytes,4,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+	// halls capabilities names, screenings halls must have all specified capabilities, for multiple values use ',' as separator
+	HallsCapabilities *string `protobuf:"bytes,5,opt,name=hallsCapabilities,json=halls_capabilities,proto3,oneof" json:"hallsCapabilities,omitempty"`
+	// ISO 639-1 code of the spoken language, for example en
+	SpokenLanguage *string `protobuf:"bytes,6,opt,name=spokenLanguage,json=spoken_language,proto3,oneof" json:"spokenLanguage,omitempty"`
+	// ISO 639-1 code of the subtitles language
+	SubtitlesLanguage *string `protobuf:"bytes,7,opt,name=subtitlesLanguage,json=subtitles_language,proto3,oneof" json:"subtitlesLanguage,omitempty"`
+	// if true, returns only screenings in the original language
+	OriginalLanguage bool `protobuf:"varint,8,opt,name=originalLanguage,json=original_language,proto3" json:"originalLanguage,omitempty"`
+	// if true, returns only screenings with audio description
+	AudioDescription bool `protobuf:"varint,9,opt,name=audioDescription,json=audio_description,proto3" json:"audioDescription,omitempty"`
+	// if true, returns only screenings with closed captions
+	ClosedCaptions bool `protobuf:"varint,10,opt,name=closedCaptions,json=closed_captions,proto3" json:"closedCaptions,omitempty"`
+	// if true, returns only relaxed (sensory-friendly) screenings
+	Relaxed bool `protobuf:"varint,11,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
+	// allowed age ratings, for multiple values use ',' as separator
+	AgeRatings *string `protobuf:"bytes,12,opt,name=ageRatings,json=age_ratings,proto3,oneof" json:"ageRatings,omitempty"`
+	// if true, cancelled and moved screenings will be returned too
+	IncludeCancelled bool `protobuf:"varint,13,opt,name=includeCancelled,json=include_cancelled,proto3" json:"includeCancelled,omitempty"`
+	// if true, returns only screenings with tickets on sale at the moment
+	PurchasableOnly bool `protobuf:"varint,14,opt,name=purchasableOnly,json=purchasable_only,proto3" json:"purchasableOnly,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,15,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+	// if true, the movie title and the end time will be received from the movie catalog
+	Enrich bool `protobuf:"varint,16,opt,name=enrich,proto3" json:"enrich,omitempty"`
 }
 
-func (x *Screening) Reset() {
-	*x = Screening{}
+func (x *GetScreeningsRequest) Reset() {
+	*x = GetScreeningsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cinema_service_v1_messages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,13 +920,13 @@ func (x *Screening) Reset() {
 	}
 }
 
-func (x *Screening) String() string {
+func (x *GetScreeningsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Screening) ProtoMessage() {}
+func (*GetScreeningsRequest) ProtoMessage() {}
 
-func (x *Screening) ProtoReflect() protoreflect.Message {
+func (x *GetScreeningsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cinema_service_v1_messages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -467,125 +938,159 @@ func (x *Screening) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Screening.ProtoReflect.Descriptor instead.
-func (*Screening) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScreeningsRequest.ProtoReflect.Descriptor instead.
+func (*GetScreeningsRequest) Descriptor() ([]byte, []int) {
 	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Screening) GetScreeningID() int64 {
+func (x *GetScreeningsRequest) GetCinemaID() int32 {
 	if x != nil {
-		return x.ScreeningID
+		return x.CinemaID
 	}
 	return 0
 }
 
-func (x *Screening) GetMovieID() int32 {
+func (x *GetScreeningsRequest) GetMovieID() int32 {
 	if x != nil {
 		return x.MovieID
 	}
 	return 0
 }
 
-func (x *Screening) GetScreeningType() string {
+func (x *GetScreeningsRequest) GetStartPeriod() *Timestamp {
 	if x != nil {
-		return x.ScreeningType
+		return x.StartPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Screening) GetStartTime() *Timestamp {
+func (x *GetScreeningsRequest) GetEndPeriod() *Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.EndPeriod
 	}
 	return nil
 }
 
-func (x *Screening) GetHallID() int32 {
-	if x != nil {
-		return x.HallID
+func (x *GetScreeningsRequest) GetHallsCapabilities() string {
+	if x != nil && x.HallsCapabilities != nil {
+		return *x.HallsCapabilities
 	}
-	return 0
+	return ""
 }
 
-func (x *Screening) GetTicketPrice() *Price {
+func (x *GetScreeningsRequest) GetSpokenLanguage() string {
+	if x != nil && x.SpokenLanguage != nil {
+		return *x.SpokenLanguage
+	}
+	return ""
+}
+
+func (x *GetScreeningsRequest) GetSubtitlesLanguage() string {
+	if x != nil && x.SubtitlesLanguage != nil {
+		return *x.SubtitlesLanguage
+	}
+	return ""
+}
+
+func (x *GetScreeningsRequest) GetOriginalLanguage() bool {
 	if x != nil {
-		return x.TicketPrice
+		return x.OriginalLanguage
 	}
-	return nil
+	return false
 }
 
-type Screenings struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetScreeningsRequest) GetAudioDescription() bool {
+	if x != nil {
+		return x.AudioDescription
+	}
+	return false
+}
 
-	Screenings []*Screening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+func (x *GetScreeningsRequest) GetClosedCaptions() bool {
+	if x != nil {
+		return x.ClosedCaptions
+	}
+	return false
 }
 
-func (x *Screenings) Reset() {
-	*x = Screenings{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetScreeningsRequest) GetRelaxed() bool {
+	if x != nil {
+		return x.Relaxed
 	}
+	return false
 }
 
-func (x *Screenings) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetScreeningsRequest) GetAgeRatings() string {
+	if x != nil && x.AgeRatings != nil {
+		return *x.AgeRatings
+	}
+	return ""
 }
 
-func (*Screenings) ProtoMessage() {}
+func (x *GetScreeningsRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
 
-func (x *Screenings) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetScreeningsRequest) GetPurchasableOnly() bool {
+	if x != nil {
+		return x.PurchasableOnly
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Screenings.ProtoReflect.Descriptor instead.
-func (*Screenings) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{8}
+func (x *GetScreeningsRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
 }
 
-func (x *Screenings) GetScreenings() []*Screening {
+func (x *GetScreeningsRequest) GetEnrich() bool {
 	if x != nil {
-		return x.Screenings
+		return x.Enrich
 	}
-	return nil
+	return false
 }
 
-type GetCinemasInCityRequest struct {
+type ScreeningAttributes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CityID int32 `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
-}
-
-func (x *GetCinemasInCityRequest) Reset() {
-	*x = GetCinemasInCityRequest{}
+	// ISO 639-1 code of the spoken language
+	SpokenLanguage string `protobuf:"bytes,1,opt,name=spokenLanguage,json=spoken_language,proto3" json:"spokenLanguage,omitempty"`
+	// ISO 639-1 code of the subtitles language, empty if the screening is without subtitles
+	SubtitlesLanguage string `protobuf:"bytes,2,opt,name=subtitlesLanguage,json=subtitles_language,proto3" json:"subtitlesLanguage,omitempty"`
+	// the movie is screened in the original language
+	OriginalLanguage bool `protobuf:"varint,3,opt,name=originalLanguage,json=original_language,proto3" json:"originalLanguage,omitempty"`
+	AudioDescription bool `protobuf:"varint,4,opt,name=audioDescription,json=audio_description,proto3" json:"audioDescription,omitempty"`
+	ClosedCaptions   bool `protobuf:"varint,5,opt,name=closedCaptions,json=closed_captions,proto3" json:"closedCaptions,omitempty"`
+	// for example 0+, 6+, 12+, 16+, 18+
+	AgeRating string `protobuf:"bytes,6,opt,name=ageRating,json=age_rating,proto3" json:"ageRating,omitempty"`
+	// relaxed (sensory-friendly) screening
+	Relaxed bool `protobuf:"varint,7,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
+}
+
+func (x *ScreeningAttributes) Reset() {
+	*x = ScreeningAttributes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[9]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCinemasInCityRequest) String() string {
+func (x *ScreeningAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCinemasInCityRequest) ProtoMessage() {}
+func (*ScreeningAttributes) ProtoMessage() {}
 
-func (x *GetCinemasInCityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[9]
+func (x *ScreeningAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,101 +1101,90 @@ func (x *GetCinemasInCityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCinemasInCityRequest.ProtoReflect.Descriptor instead.
-func (*GetCinemasInCityRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ScreeningAttributes.ProtoReflect.Descriptor instead.
+func (*ScreeningAttributes) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCinemasInCityRequest) GetCityID() int32 {
+func (x *ScreeningAttributes) GetSpokenLanguage() string {
 	if x != nil {
-		return x.CityID
+		return x.SpokenLanguage
 	}
-	return 0
-}
-
-type Coordinates struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Latityde  float64 `protobuf:"fixed64,1,opt,name=latityde,proto3" json:"latityde,omitempty"`
-	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	return ""
 }
 
-func (x *Coordinates) Reset() {
-	*x = Coordinates{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ScreeningAttributes) GetSubtitlesLanguage() string {
+	if x != nil {
+		return x.SubtitlesLanguage
 	}
+	return ""
 }
 
-func (x *Coordinates) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScreeningAttributes) GetOriginalLanguage() bool {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return false
 }
 
-func (*Coordinates) ProtoMessage() {}
-
-func (x *Coordinates) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScreeningAttributes) GetAudioDescription() bool {
+	if x != nil {
+		return x.AudioDescription
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
-func (*Coordinates) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{10}
+func (x *ScreeningAttributes) GetClosedCaptions() bool {
+	if x != nil {
+		return x.ClosedCaptions
+	}
+	return false
 }
 
-func (x *Coordinates) GetLatityde() float64 {
+func (x *ScreeningAttributes) GetAgeRating() string {
 	if x != nil {
-		return x.Latityde
+		return x.AgeRating
 	}
-	return 0
+	return ""
 }
 
-func (x *Coordinates) GetLongitude() float64 {
+func (x *ScreeningAttributes) GetRelaxed() bool {
 	if x != nil {
-		return x.Longitude
+		return x.Relaxed
 	}
-	return 0
+	return false
 }
 
-type Cinema struct {
+type ScreeningStatusInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CinemaID    int32        `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
-	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address     string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Coordinates *Coordinates `protobuf:"bytes,4,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	Status ScreeningStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cinema_service.ScreeningStatus" json:"status,omitempty"`
+	// reason of the status change, for example technical problems
+	Reason    string     `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt *Timestamp `protobuf:"bytes,3,opt,name=changedAt,json=changed_at,proto3" json:"changedAt,omitempty"`
+	// screening that replaces the moved or postponed screening
+	ReplacementScreeningID *int64 `protobuf:"varint,4,opt,name=replacementScreeningID,json=replacement_screening_id,proto3,oneof" json:"replacementScreeningID,omitempty"`
 }
 
-func (x *Cinema) Reset() {
-	*x = Cinema{}
+func (x *ScreeningStatusInfo) Reset() {
+	*x = ScreeningStatusInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[11]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Cinema) String() string {
+func (x *ScreeningStatusInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cinema) ProtoMessage() {}
+func (*ScreeningStatusInfo) ProtoMessage() {}
 
-func (x *Cinema) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[11]
+func (x *ScreeningStatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,64 +1195,83 @@ func (x *Cinema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cinema.ProtoReflect.Descriptor instead.
-func (*Cinema) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ScreeningStatusInfo.ProtoReflect.Descriptor instead.
+func (*ScreeningStatusInfo) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Cinema) GetCinemaID() int32 {
+func (x *ScreeningStatusInfo) GetStatus() ScreeningStatus {
 	if x != nil {
-		return x.CinemaID
+		return x.Status
 	}
-	return 0
+	return ScreeningStatus_SCREENING_STATUS_SCHEDULED
 }
 
-func (x *Cinema) GetName() string {
+func (x *ScreeningStatusInfo) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Cinema) GetAddress() string {
+func (x *ScreeningStatusInfo) GetChangedAt() *Timestamp {
 	if x != nil {
-		return x.Address
+		return x.ChangedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Cinema) GetCoordinates() *Coordinates {
-	if x != nil {
-		return x.Coordinates
+func (x *ScreeningStatusInfo) GetReplacementScreeningID() int64 {
+	if x != nil && x.ReplacementScreeningID != nil {
+		return *x.ReplacementScreeningID
 	}
-	return nil
+	return 0
 }
 
-type Cinemas struct {
+type Screening struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cinemas []*Cinema `protobuf:"bytes,1,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	ScreeningID   int64                `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+	MovieID       int32                `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	ScreeningType string               `protobuf:"bytes,3,opt,name=screeningType,json=screening_type,proto3" json:"screeningType,omitempty"`
+	StartTime     *Timestamp           `protobuf:"bytes,4,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	HallID        int32                `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	TicketPrice   *Price               `protobuf:"bytes,6,opt,name=ticketPrice,json=ticket_price,proto3" json:"ticketPrice,omitempty"`
+	Attributes    *ScreeningAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Status        *ScreeningStatusInfo `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// tickets sales period
+	SalesOpenAt  *Timestamp `protobuf:"bytes,9,opt,name=salesOpenAt,json=sales_open_at,proto3" json:"salesOpenAt,omitempty"`
+	SalesCloseAt *Timestamp `protobuf:"bytes,10,opt,name=salesCloseAt,json=sales_close_at,proto3" json:"salesCloseAt,omitempty"`
+	// tickets for the screening can be bought at the moment
+	Purchasable bool  `protobuf:"varint,11,opt,name=purchasable,proto3" json:"purchasable,omitempty"`
+	CinemaID    int32 `protobuf:"varint,12,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	// ids of the events that the screening belongs to
+	EventsIDs []int32 `protobuf:"varint,13,rep,packed,name=eventsIDs,json=events_ids,proto3" json:"eventsIDs,omitempty"`
+	// empty if the end time is unknown
+	EndTime *Timestamp `protobuf:"bytes,14,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	// empty if the screening is not enriched
+	MovieTitle string `protobuf:"bytes,15,opt,name=movieTitle,json=movie_title,proto3" json:"movieTitle,omitempty"`
 }
 
-func (x *Cinemas) Reset() {
-	*x = Cinemas{}
+func (x *Screening) Reset() {
+	*x = Screening{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[12]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Cinemas) String() string {
+func (x *Screening) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cinemas) ProtoMessage() {}
+func (*Screening) ProtoMessage() {}
 
-func (x *Cinemas) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[12]
+func (x *Screening) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,98 +1282,141 @@ func (x *Cinemas) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cinemas.ProtoReflect.Descriptor instead.
-func (*Cinemas) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Screening.ProtoReflect.Descriptor instead.
+func (*Screening) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Cinemas) GetCinemas() []*Cinema {
+func (x *Screening) GetScreeningID() int64 {
 	if x != nil {
-		return x.Cinemas
+		return x.ScreeningID
 	}
-	return nil
+	return 0
 }
 
-type City struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CityID int32  `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Screening) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
 }
 
-func (x *City) Reset() {
-	*x = City{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Screening) GetScreeningType() string {
+	if x != nil {
+		return x.ScreeningType
 	}
+	return ""
 }
 
-func (x *City) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Screening) GetStartTime() *Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (*City) ProtoMessage() {}
+func (x *Screening) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
 
-func (x *City) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Screening) GetTicketPrice() *Price {
+	if x != nil {
+		return x.TicketPrice
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use City.ProtoReflect.Descriptor instead.
-func (*City) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{13}
+func (x *Screening) GetAttributes() *ScreeningAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
 }
 
-func (x *City) GetCityID() int32 {
+func (x *Screening) GetStatus() *ScreeningStatusInfo {
 	if x != nil {
-		return x.CityID
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Screening) GetSalesOpenAt() *Timestamp {
+	if x != nil {
+		return x.SalesOpenAt
+	}
+	return nil
+}
+
+func (x *Screening) GetSalesCloseAt() *Timestamp {
+	if x != nil {
+		return x.SalesCloseAt
+	}
+	return nil
+}
+
+func (x *Screening) GetPurchasable() bool {
+	if x != nil {
+		return x.Purchasable
+	}
+	return false
+}
+
+func (x *Screening) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
 	}
 	return 0
 }
 
-func (x *City) GetName() string {
+func (x *Screening) GetEventsIDs() []int32 {
 	if x != nil {
-		return x.Name
+		return x.EventsIDs
+	}
+	return nil
+}
+
+func (x *Screening) GetEndTime() *Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Screening) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
 	}
 	return ""
 }
 
-type Cities struct {
+type Screenings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cities []*City `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	Screenings []*Screening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
 }
 
-func (x *Cities) Reset() {
-	*x = Cities{}
+func (x *Screenings) Reset() {
+	*x = Screenings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[14]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Cities) String() string {
+func (x *Screenings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cities) ProtoMessage() {}
+func (*Screenings) ProtoMessage() {}
 
-func (x *Cities) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[14]
+func (x *Screenings) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,46 +1427,49 @@ func (x *Cities) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cities.ProtoReflect.Descriptor instead.
-func (*Cities) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Screenings.ProtoReflect.Descriptor instead.
+func (*Screenings) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Cities) GetCities() []*City {
+func (x *Screenings) GetScreenings() []*Screening {
 	if x != nil {
-		return x.Cities
+		return x.Screenings
 	}
 	return nil
 }
 
-type Hall struct {
+type GetCinemasInCityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HallID   int32  `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
-	HallSize uint32 `protobuf:"varint,2,opt,name=hallSize,json=hall_size,proto3" json:"hallSize,omitempty"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	CityID int32 `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	// if specified, returns only cinemas open at this time
+	OpenAt *Timestamp `protobuf:"bytes,2,opt,name=openAt,json=open_at,proto3" json:"openAt,omitempty"`
+	// amenities tags, cinemas must have all specified amenities, for multiple values use ',' as separator
+	Amenities *string `protobuf:"bytes,3,opt,name=amenities,proto3,oneof" json:"amenities,omitempty"`
+	// only cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,4,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
 }
 
-func (x *Hall) Reset() {
-	*x = Hall{}
+func (x *GetCinemasInCityRequest) Reset() {
+	*x = GetCinemasInCityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[15]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Hall) String() string {
+func (x *GetCinemasInCityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hall) ProtoMessage() {}
+func (*GetCinemasInCityRequest) ProtoMessage() {}
 
-func (x *Hall) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[15]
+func (x *GetCinemasInCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,64 +1480,65 @@ func (x *Hall) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hall.ProtoReflect.Descriptor instead.
-func (*Hall) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetCinemasInCityRequest.ProtoReflect.Descriptor instead.
+func (*GetCinemasInCityRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Hall) GetHallID() int32 {
+func (x *GetCinemasInCityRequest) GetCityID() int32 {
 	if x != nil {
-		return x.HallID
+		return x.CityID
 	}
 	return 0
 }
 
-func (x *Hall) GetHallSize() uint32 {
+func (x *GetCinemasInCityRequest) GetOpenAt() *Timestamp {
 	if x != nil {
-		return x.HallSize
+		return x.OpenAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Hall) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *GetCinemasInCityRequest) GetAmenities() string {
+	if x != nil && x.Amenities != nil {
+		return *x.Amenities
 	}
 	return ""
 }
 
-func (x *Hall) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *GetCinemasInCityRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
 	}
 	return ""
 }
 
-type Halls struct {
+type Coordinates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Halls []*Hall `protobuf:"bytes,1,rep,name=halls,proto3" json:"halls,omitempty"`
+	Latityde  float64 `protobuf:"fixed64,1,opt,name=latityde,proto3" json:"latityde,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 }
 
-func (x *Halls) Reset() {
-	*x = Halls{}
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[16]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Halls) String() string {
+func (x *Coordinates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Halls) ProtoMessage() {}
+func (*Coordinates) ProtoMessage() {}
 
-func (x *Halls) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[16]
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,43 +1549,69 @@ func (x *Halls) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Halls.ProtoReflect.Descriptor instead.
-func (*Halls) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Halls) GetHalls() []*Hall {
+func (x *Coordinates) GetLatityde() float64 {
 	if x != nil {
-		return x.Halls
+		return x.Latityde
 	}
-	return nil
+	return 0
 }
 
-type GetCinemaRequest struct {
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Cinema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,proto3" json:"cinemaID,omitempty"`
+	CinemaID    int32        `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address     string       `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Coordinates *Coordinates `protobuf:"bytes,4,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	// IANA time zone name, for example Europe/Moscow, opening hours are in this time zone
+	TimeZone string `protobuf:"bytes,5,opt,name=timeZone,json=time_zone,proto3" json:"timeZone,omitempty"`
+	// weekly opening hours, cinema without opening hours is considered always open
+	OpeningHours []*OpeningHours `protobuf:"bytes,6,rep,name=openingHours,json=opening_hours,proto3" json:"openingHours,omitempty"`
+	// current and upcoming closures, for example holidays or renovations
+	Closures []*CinemaClosure `protobuf:"bytes,7,rep,name=closures,proto3" json:"closures,omitempty"`
+	OpenNow  bool             `protobuf:"varint,8,opt,name=openNow,json=open_now,proto3" json:"openNow,omitempty"`
+	Phone    string           `protobuf:"bytes,9,opt,name=phone,proto3" json:"phone,omitempty"`
+	Website  string           `protobuf:"bytes,10,opt,name=website,proto3" json:"website,omitempty"`
+	// amenities tags, for example parking, food_court, wheelchair_access
+	Amenities  []string `protobuf:"bytes,11,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	PhotosUrls []string `protobuf:"bytes,12,rep,name=photosUrls,json=photos_urls,proto3" json:"photosUrls,omitempty"`
+	// 0 if the cinema doesn't belong to any chain
+	ChainID int32  `protobuf:"varint,13,opt,name=chainID,json=chain_id,proto3" json:"chainID,omitempty"`
+	Chain   string `protobuf:"bytes,14,opt,name=chain,proto3" json:"chain,omitempty"`
+	Brand   string `protobuf:"bytes,15,opt,name=brand,proto3" json:"brand,omitempty"`
 }
 
-func (x *GetCinemaRequest) Reset() {
-	*x = GetCinemaRequest{}
+func (x *Cinema) Reset() {
+	*x = Cinema{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[17]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCinemaRequest) String() string {
+func (x *Cinema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCinemaRequest) ProtoMessage() {}
+func (*Cinema) ProtoMessage() {}
 
-func (x *GetCinemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[17]
+func (x *Cinema) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,201 +1622,145 @@ func (x *GetCinemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCinemaRequest.ProtoReflect.Descriptor instead.
-func (*GetCinemaRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Cinema.ProtoReflect.Descriptor instead.
+func (*Cinema) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetCinemaRequest) GetCinemaID() int32 {
+func (x *Cinema) GetCinemaID() int32 {
 	if x != nil {
 		return x.CinemaID
 	}
 	return 0
 }
 
-type GetScreeningsInCityRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CityID      int32      `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
-	MovieID     int32      `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
-	StartPeriod *Timestamp `protobuf:"bytes,3,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
-	EndPeriod   *Timestamp `protobuf:"bytes,4,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+func (x *Cinema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetScreeningsInCityRequest) Reset() {
-	*x = GetScreeningsInCityRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Cinema) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
+	return ""
 }
 
-func (x *GetScreeningsInCityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Cinema) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
 }
 
-func (*GetScreeningsInCityRequest) ProtoMessage() {}
-
-func (x *GetScreeningsInCityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Cinema) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetScreeningsInCityRequest.ProtoReflect.Descriptor instead.
-func (*GetScreeningsInCityRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetScreeningsInCityRequest) GetCityID() int32 {
-	if x != nil {
-		return x.CityID
-	}
-	return 0
-}
-
-func (x *GetScreeningsInCityRequest) GetMovieID() int32 {
-	if x != nil {
-		return x.MovieID
-	}
-	return 0
-}
-
-func (x *GetScreeningsInCityRequest) GetStartPeriod() *Timestamp {
+func (x *Cinema) GetOpeningHours() []*OpeningHours {
 	if x != nil {
-		return x.StartPeriod
+		return x.OpeningHours
 	}
 	return nil
 }
 
-func (x *GetScreeningsInCityRequest) GetEndPeriod() *Timestamp {
+func (x *Cinema) GetClosures() []*CinemaClosure {
 	if x != nil {
-		return x.EndPeriod
+		return x.Closures
 	}
 	return nil
 }
 
-type CityScreening struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ScreeningID   int64      `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
-	CinemaID      int32      `protobuf:"varint,2,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
-	ScreeningType string     `protobuf:"bytes,3,opt,name=screeningType,json=screening_type,proto3" json:"screeningType,omitempty"`
-	StartTime     *Timestamp `protobuf:"bytes,4,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
-	HallID        int32      `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
-	TicketPrice   *Price     `protobuf:"bytes,6,opt,name=ticketPrice,json=ticket_price,proto3" json:"ticketPrice,omitempty"`
-}
-
-func (x *CityScreening) Reset() {
-	*x = CityScreening{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Cinema) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
 	}
+	return false
 }
 
-func (x *CityScreening) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CityScreening) ProtoMessage() {}
-
-func (x *CityScreening) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Cinema) GetPhone() string {
+	if x != nil {
+		return x.Phone
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CityScreening.ProtoReflect.Descriptor instead.
-func (*CityScreening) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{19}
+	return ""
 }
 
-func (x *CityScreening) GetScreeningID() int64 {
+func (x *Cinema) GetWebsite() string {
 	if x != nil {
-		return x.ScreeningID
+		return x.Website
 	}
-	return 0
+	return ""
 }
 
-func (x *CityScreening) GetCinemaID() int32 {
+func (x *Cinema) GetAmenities() []string {
 	if x != nil {
-		return x.CinemaID
+		return x.Amenities
 	}
-	return 0
+	return nil
 }
 
-func (x *CityScreening) GetScreeningType() string {
+func (x *Cinema) GetPhotosUrls() []string {
 	if x != nil {
-		return x.ScreeningType
+		return x.PhotosUrls
 	}
-	return ""
+	return nil
 }
 
-func (x *CityScreening) GetStartTime() *Timestamp {
+func (x *Cinema) GetChainID() int32 {
 	if x != nil {
-		return x.StartTime
+		return x.ChainID
 	}
-	return nil
+	return 0
 }
 
-func (x *CityScreening) GetHallID() int32 {
+func (x *Cinema) GetChain() string {
 	if x != nil {
-		return x.HallID
+		return x.Chain
 	}
-	return 0
+	return ""
 }
 
-func (x *CityScreening) GetTicketPrice() *Price {
+func (x *Cinema) GetBrand() string {
 	if x != nil {
-		return x.TicketPrice
+		return x.Brand
 	}
-	return nil
+	return ""
 }
 
-type CityScreenings struct {
+type OpeningHours struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Screenings []*CityScreening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+	// day of the week, 0 is Sunday
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// time in format HH:MM, if closes_at is not greater than opens_at, cinema closes after midnight
+	OpensAt  string `protobuf:"bytes,2,opt,name=opensAt,json=opens_at,proto3" json:"opensAt,omitempty"`
+	ClosesAt string `protobuf:"bytes,3,opt,name=closesAt,json=closes_at,proto3" json:"closesAt,omitempty"`
 }
 
-func (x *CityScreenings) Reset() {
-	*x = CityScreenings{}
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[20]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CityScreenings) String() string {
+func (x *OpeningHours) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CityScreenings) ProtoMessage() {}
+func (*OpeningHours) ProtoMessage() {}
 
-func (x *CityScreenings) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[20]
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,44 +1771,59 @@ func (x *CityScreenings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CityScreenings.ProtoReflect.Descriptor instead.
-func (*CityScreenings) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CityScreenings) GetScreenings() []*CityScreening {
+func (x *OpeningHours) GetWeekday() int32 {
 	if x != nil {
-		return x.Screenings
+		return x.Weekday
 	}
-	return nil
+	return 0
 }
 
-type GetHallsRequest struct {
+func (x *OpeningHours) GetOpensAt() string {
+	if x != nil {
+		return x.OpensAt
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetClosesAt() string {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return ""
+}
+
+type CinemaClosure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// for multiple values use ',' separator
-	HallsIds string `protobuf:"bytes,1,opt,name=hallsIds,json=halls_ids,proto3" json:"hallsIds,omitempty"`
+	StartTime *Timestamp `protobuf:"bytes,1,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	EndTime   *Timestamp `protobuf:"bytes,2,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	Reason    string     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetHallsRequest) Reset() {
-	*x = GetHallsRequest{}
+func (x *CinemaClosure) Reset() {
+	*x = CinemaClosure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[21]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHallsRequest) String() string {
+func (x *CinemaClosure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHallsRequest) ProtoMessage() {}
+func (*CinemaClosure) ProtoMessage() {}
 
-func (x *GetHallsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[21]
+func (x *CinemaClosure) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1289,43 +1834,57 @@ func (x *GetHallsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHallsRequest.ProtoReflect.Descriptor instead.
-func (*GetHallsRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CinemaClosure.ProtoReflect.Descriptor instead.
+func (*CinemaClosure) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetHallsRequest) GetHallsIds() string {
+func (x *CinemaClosure) GetStartTime() *Timestamp {
 	if x != nil {
-		return x.HallsIds
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CinemaClosure) GetEndTime() *Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CinemaClosure) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type GetHallConfigurationRequest struct {
+type Cinemas struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HallID int32 `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	Cinemas []*Cinema `protobuf:"bytes,1,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
 }
 
-func (x *GetHallConfigurationRequest) Reset() {
-	*x = GetHallConfigurationRequest{}
+func (x *Cinemas) Reset() {
+	*x = Cinemas{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[22]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHallConfigurationRequest) String() string {
+func (x *Cinemas) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHallConfigurationRequest) ProtoMessage() {}
+func (*Cinemas) ProtoMessage() {}
 
-func (x *GetHallConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[22]
+func (x *Cinemas) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,46 +1895,52 @@ func (x *GetHallConfigurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHallConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*GetHallConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Cinemas.ProtoReflect.Descriptor instead.
+func (*Cinemas) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetHallConfigurationRequest) GetHallID() int32 {
+func (x *Cinemas) GetCinemas() []*Cinema {
 	if x != nil {
-		return x.HallID
+		return x.Cinemas
 	}
-	return 0
+	return nil
 }
 
-type Place struct {
+type City struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Row      int32   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
-	Seat     int32   `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
-	GridPosX float32 `protobuf:"fixed32,3,opt,name=grid_pos_x,json=gridPosX,proto3" json:"grid_pos_x,omitempty"`
-	GridPosY float32 `protobuf:"fixed32,4,opt,name=grid_pos_y,json=gridPosY,proto3" json:"grid_pos_y,omitempty"`
+	CityID int32  `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 0 if the city is not in any region
+	RegionID int32 `protobuf:"varint,3,opt,name=regionID,json=region_id,proto3" json:"regionID,omitempty"`
+	// ISO 4217 code, the country default if the city doesn't override it
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// IANA time zone name, the country default if the city doesn't override it
+	TimeZone string `protobuf:"bytes,5,opt,name=timeZone,json=time_zone,proto3" json:"timeZone,omitempty"`
+	// ISO 639-1 code, the country default if the city doesn't override it
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *Place) Reset() {
-	*x = Place{}
+func (x *City) Reset() {
+	*x = City{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[23]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Place) String() string {
+func (x *City) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Place) ProtoMessage() {}
+func (*City) ProtoMessage() {}
 
-func (x *Place) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[23]
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,66 +1951,78 @@ func (x *Place) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Place.ProtoReflect.Descriptor instead.
-func (*Place) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Place) GetRow() int32 {
+func (x *City) GetCityID() int32 {
 	if x != nil {
-		return x.Row
+		return x.CityID
 	}
 	return 0
 }
 
-func (x *Place) GetSeat() int32 {
+func (x *City) GetName() string {
 	if x != nil {
-		return x.Seat
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Place) GetGridPosX() float32 {
+func (x *City) GetRegionID() int32 {
 	if x != nil {
-		return x.GridPosX
+		return x.RegionID
 	}
 	return 0
 }
 
-func (x *Place) GetGridPosY() float32 {
+func (x *City) GetCurrency() string {
 	if x != nil {
-		return x.GridPosY
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-type GetScreeningRequest struct {
+func (x *City) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *City) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type Cities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScreeningID int64 `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
-	// Fields to return, valid array values is GetScreeningResponse fields names, leave it empty if you want get all fields
-	Mask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
+	Cities []*City `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
 }
 
-func (x *GetScreeningRequest) Reset() {
-	*x = GetScreeningRequest{}
+func (x *Cities) Reset() {
+	*x = Cities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[24]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetScreeningRequest) String() string {
+func (x *Cities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScreeningRequest) ProtoMessage() {}
+func (*Cities) ProtoMessage() {}
 
-func (x *GetScreeningRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[24]
+func (x *Cities) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,56 +2033,113 @@ func (x *GetScreeningRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScreeningRequest.ProtoReflect.Descriptor instead.
-func (*GetScreeningRequest) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Cities.ProtoReflect.Descriptor instead.
+func (*Cities) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetScreeningRequest) GetScreeningID() int64 {
+func (x *Cities) GetCities() []*City {
 	if x != nil {
-		return x.ScreeningID
+		return x.Cities
 	}
-	return 0
+	return nil
 }
 
-func (x *GetScreeningRequest) GetMask() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.Mask
+type HallCategoryCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seat category name, for example standard or vip
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (x *HallCategoryCapacity) Reset() {
+	*x = HallCategoryCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type GetScreeningResponse struct {
+func (x *HallCategoryCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HallCategoryCapacity) ProtoMessage() {}
+
+func (x *HallCategoryCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallCategoryCapacity.ProtoReflect.Descriptor instead.
+func (*HallCategoryCapacity) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HallCategoryCapacity) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *HallCategoryCapacity) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type Hall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CinemaID          int32              `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
-	MovieID           int32              `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
-	ScreeningType     string             `protobuf:"bytes,3,opt,name=screening_type,proto3" json:"screening_type,omitempty"`
-	StartTime         *Timestamp         `protobuf:"bytes,4,opt,name=start_time,proto3" json:"start_time,omitempty"`
-	HallID            int32              `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
-	TicketPrice       *Price             `protobuf:"bytes,6,opt,name=ticket_price,proto3" json:"ticket_price,omitempty"`
-	HallConfiguration *HallConfiguration `protobuf:"bytes,7,opt,name=hall_configuration,proto3" json:"hall_configuration,omitempty"`
+	HallID int32 `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	// number of places in the hall
+	HallSize uint32 `protobuf:"varint,2,opt,name=hallSize,json=hall_size,proto3" json:"hallSize,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// number of places suitable for wheelchair users
+	AccessibleSize uint32 `protobuf:"varint,5,opt,name=accessibleSize,json=accessible_size,proto3" json:"accessibleSize,omitempty"`
+	// capacity of the hall per seat category
+	Categories []*HallCategoryCapacity `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	// hall capabilities names, for example IMAX, Dolby Atmos, recliners, includes the hall type
+	Capabilities []string         `protobuf:"bytes,7,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	CinemaID     int32            `protobuf:"varint,8,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	Availability HallAvailability `protobuf:"varint,9,opt,name=availability,proto3,enum=cinema_service.HallAvailability" json:"availability,omitempty"`
+	// current maintenance window, empty if the hall is available
+	CurrentMaintenance *HallMaintenanceWindow `protobuf:"bytes,10,opt,name=currentMaintenance,json=current_maintenance,proto3" json:"currentMaintenance,omitempty"`
 }
 
-func (x *GetScreeningResponse) Reset() {
-	*x = GetScreeningResponse{}
+func (x *Hall) Reset() {
+	*x = Hall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[25]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetScreeningResponse) String() string {
+func (x *Hall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScreeningResponse) ProtoMessage() {}
+func (*Hall) ProtoMessage() {}
 
-func (x *GetScreeningResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[25]
+func (x *Hall) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,450 +2150,5567 @@ func (x *GetScreeningResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScreeningResponse.ProtoReflect.Descriptor instead.
-func (*GetScreeningResponse) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Hall.ProtoReflect.Descriptor instead.
+func (*Hall) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetScreeningResponse) GetCinemaID() int32 {
+func (x *Hall) GetHallID() int32 {
 	if x != nil {
-		return x.CinemaID
+		return x.HallID
 	}
 	return 0
 }
 
-func (x *GetScreeningResponse) GetMovieID() int32 {
+func (x *Hall) GetHallSize() uint32 {
 	if x != nil {
-		return x.MovieID
+		return x.HallSize
 	}
 	return 0
 }
 
-func (x *GetScreeningResponse) GetScreeningType() string {
+func (x *Hall) GetName() string {
 	if x != nil {
-		return x.ScreeningType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetScreeningResponse) GetStartTime() *Timestamp {
+func (x *Hall) GetType() string {
 	if x != nil {
-		return x.StartTime
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Hall) GetAccessibleSize() uint32 {
+	if x != nil {
+		return x.AccessibleSize
+	}
+	return 0
+}
+
+func (x *Hall) GetCategories() []*HallCategoryCapacity {
+	if x != nil {
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *GetScreeningResponse) GetHallID() int32 {
+func (x *Hall) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *Hall) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+func (x *Hall) GetAvailability() HallAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return HallAvailability_HALL_AVAILABILITY_AVAILABLE
+}
+
+func (x *Hall) GetCurrentMaintenance() *HallMaintenanceWindow {
+	if x != nil {
+		return x.CurrentMaintenance
+	}
+	return nil
+}
+
+type HallMaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaintenanceWindowID int32      `protobuf:"varint,1,opt,name=maintenanceWindowID,json=maintenance_window_id,proto3" json:"maintenanceWindowID,omitempty"`
+	HallID              int32      `protobuf:"varint,2,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	StartTime           *Timestamp `protobuf:"bytes,3,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	EndTime             *Timestamp `protobuf:"bytes,4,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	// for example projector servicing or cleaning
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// ids of the scheduled screenings in the window, that need a reschedule
+	AffectedScreeningsIDs []int64 `protobuf:"varint,6,rep,packed,name=affectedScreeningsIDs,json=affected_screenings_ids,proto3" json:"affectedScreeningsIDs,omitempty"`
+}
+
+func (x *HallMaintenanceWindow) Reset() {
+	*x = HallMaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HallMaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HallMaintenanceWindow) ProtoMessage() {}
+
+func (x *HallMaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallMaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*HallMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HallMaintenanceWindow) GetMaintenanceWindowID() int32 {
+	if x != nil {
+		return x.MaintenanceWindowID
+	}
+	return 0
+}
+
+func (x *HallMaintenanceWindow) GetHallID() int32 {
 	if x != nil {
 		return x.HallID
 	}
 	return 0
 }
 
-func (x *GetScreeningResponse) GetTicketPrice() *Price {
+func (x *HallMaintenanceWindow) GetStartTime() *Timestamp {
 	if x != nil {
-		return x.TicketPrice
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *GetScreeningResponse) GetHallConfiguration() *HallConfiguration {
+func (x *HallMaintenanceWindow) GetEndTime() *Timestamp {
 	if x != nil {
-		return x.HallConfiguration
+		return x.EndTime
 	}
 	return nil
 }
 
-type HallConfiguration struct {
+func (x *HallMaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HallMaintenanceWindow) GetAffectedScreeningsIDs() []int64 {
+	if x != nil {
+		return x.AffectedScreeningsIDs
+	}
+	return nil
+}
+
+type HallMaintenanceWindows struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Place []*Place `protobuf:"bytes,1,rep,name=place,proto3" json:"place,omitempty"`
+	Windows []*HallMaintenanceWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
 }
 
-func (x *HallConfiguration) Reset() {
-	*x = HallConfiguration{}
+func (x *HallMaintenanceWindows) Reset() {
+	*x = HallMaintenanceWindows{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[26]
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HallConfiguration) String() string {
+func (x *HallMaintenanceWindows) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HallConfiguration) ProtoMessage() {}
+func (*HallMaintenanceWindows) ProtoMessage() {}
 
-func (x *HallConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[26]
+func (x *HallMaintenanceWindows) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallMaintenanceWindows.ProtoReflect.Descriptor instead.
+func (*HallMaintenanceWindows) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HallMaintenanceWindows) GetWindows() []*HallMaintenanceWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type Halls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Halls []*Hall `protobuf:"bytes,1,rep,name=halls,proto3" json:"halls,omitempty"`
+}
+
+func (x *Halls) Reset() {
+	*x = Halls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Halls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Halls) ProtoMessage() {}
+
+func (x *Halls) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use HallConfiguration.ProtoReflect.Descriptor instead.
-func (*HallConfiguration) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *HallConfiguration) GetPlace() []*Place {
-	if x != nil {
-		return x.Place
-	}
-	return nil
-}
-
-type GetCinemaHalls struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
-}
-
-func (x *GetCinemaHalls) Reset() {
-	*x = GetCinemaHalls{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cinema_service_v1_messages_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetCinemaHalls) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCinemaHalls) ProtoMessage() {}
-
-func (x *GetCinemaHalls) ProtoReflect() protoreflect.Message {
-	mi := &file_cinema_service_v1_messages_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Halls.ProtoReflect.Descriptor instead.
+func (*Halls) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Halls) GetHalls() []*Hall {
+	if x != nil {
+		return x.Halls
+	}
+	return nil
+}
+
+type GetCinemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,proto3" json:"cinemaID,omitempty"`
+}
+
+func (x *GetCinemaRequest) Reset() {
+	*x = GetCinemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCinemaRequest) ProtoMessage() {}
+
+func (x *GetCinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCinemaRequest.ProtoReflect.Descriptor instead.
+func (*GetCinemaRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetCinemaRequest) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+type GetScreeningsInCityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityID      int32      `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	MovieID     int32      `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	StartPeriod *Timestamp `protobuf:"bytes,3,opt,name=startPeriod,json=start_period,proto3" json:"startPeriod,omitempty"`
+	EndPeriod   *Timestamp `protobuf:"bytes,4,opt,name=endPeriod,json=end_period,proto3" json:"endPeriod,omitempty"`
+	// halls capabilities names, screenings halls must have all specified capabilities, for multiple values use ',' as separator
+	HallsCapabilities *string `protobuf:"bytes,5,opt,name=hallsCapabilities,json=halls_capabilities,proto3,oneof" json:"hallsCapabilities,omitempty"`
+	// ISO 639-1 code of the spoken language, for example en
+	SpokenLanguage *string `protobuf:"bytes,6,opt,name=spokenLanguage,json=spoken_language,proto3,oneof" json:"spokenLanguage,omitempty"`
+	// ISO 639-1 code of the subtitles language
+	SubtitlesLanguage *string `protobuf:"bytes,7,opt,name=subtitlesLanguage,json=subtitles_language,proto3,oneof" json:"subtitlesLanguage,omitempty"`
+	// if true, returns only screenings in the original language
+	OriginalLanguage bool `protobuf:"varint,8,opt,name=originalLanguage,json=original_language,proto3" json:"originalLanguage,omitempty"`
+	// if true, returns only screenings with audio description
+	AudioDescription bool `protobuf:"varint,9,opt,name=audioDescription,json=audio_description,proto3" json:"audioDescription,omitempty"`
+	// if true, returns only screenings with closed captions
+	ClosedCaptions bool `protobuf:"varint,10,opt,name=closedCaptions,json=closed_captions,proto3" json:"closedCaptions,omitempty"`
+	// if true, returns only relaxed (sensory-friendly) screenings
+	Relaxed bool `protobuf:"varint,11,opt,name=relaxed,proto3" json:"relaxed,omitempty"`
+	// allowed age ratings, for multiple values use ',' as separator
+	AgeRatings *string `protobuf:"bytes,12,opt,name=ageRatings,json=age_ratings,proto3,oneof" json:"ageRatings,omitempty"`
+	// if true, cancelled and moved screenings will be returned too
+	IncludeCancelled bool `protobuf:"varint,13,opt,name=includeCancelled,json=include_cancelled,proto3" json:"includeCancelled,omitempty"`
+	// if true, returns only screenings with tickets on sale at the moment
+	PurchasableOnly bool `protobuf:"varint,14,opt,name=purchasableOnly,json=purchasable_only,proto3" json:"purchasableOnly,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,15,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+	// if true, the movie title and the end time will be received from the movie catalog
+	Enrich bool `protobuf:"varint,16,opt,name=enrich,proto3" json:"enrich,omitempty"`
+}
+
+func (x *GetScreeningsInCityRequest) Reset() {
+	*x = GetScreeningsInCityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningsInCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningsInCityRequest) ProtoMessage() {}
+
+func (x *GetScreeningsInCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningsInCityRequest.ProtoReflect.Descriptor instead.
+func (*GetScreeningsInCityRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetScreeningsInCityRequest) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+func (x *GetScreeningsInCityRequest) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *GetScreeningsInCityRequest) GetStartPeriod() *Timestamp {
+	if x != nil {
+		return x.StartPeriod
+	}
+	return nil
+}
+
+func (x *GetScreeningsInCityRequest) GetEndPeriod() *Timestamp {
+	if x != nil {
+		return x.EndPeriod
+	}
+	return nil
+}
+
+func (x *GetScreeningsInCityRequest) GetHallsCapabilities() string {
+	if x != nil && x.HallsCapabilities != nil {
+		return *x.HallsCapabilities
+	}
+	return ""
+}
+
+func (x *GetScreeningsInCityRequest) GetSpokenLanguage() string {
+	if x != nil && x.SpokenLanguage != nil {
+		return *x.SpokenLanguage
+	}
+	return ""
+}
+
+func (x *GetScreeningsInCityRequest) GetSubtitlesLanguage() string {
+	if x != nil && x.SubtitlesLanguage != nil {
+		return *x.SubtitlesLanguage
+	}
+	return ""
+}
+
+func (x *GetScreeningsInCityRequest) GetOriginalLanguage() bool {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetAudioDescription() bool {
+	if x != nil {
+		return x.AudioDescription
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetClosedCaptions() bool {
+	if x != nil {
+		return x.ClosedCaptions
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetRelaxed() bool {
+	if x != nil {
+		return x.Relaxed
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetAgeRatings() string {
+	if x != nil && x.AgeRatings != nil {
+		return *x.AgeRatings
+	}
+	return ""
+}
+
+func (x *GetScreeningsInCityRequest) GetIncludeCancelled() bool {
+	if x != nil {
+		return x.IncludeCancelled
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetPurchasableOnly() bool {
+	if x != nil {
+		return x.PurchasableOnly
+	}
+	return false
+}
+
+func (x *GetScreeningsInCityRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+func (x *GetScreeningsInCityRequest) GetEnrich() bool {
+	if x != nil {
+		return x.Enrich
+	}
+	return false
+}
+
+type CityScreening struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID   int64                `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+	CinemaID      int32                `protobuf:"varint,2,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	ScreeningType string               `protobuf:"bytes,3,opt,name=screeningType,json=screening_type,proto3" json:"screeningType,omitempty"`
+	StartTime     *Timestamp           `protobuf:"bytes,4,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	HallID        int32                `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	TicketPrice   *Price               `protobuf:"bytes,6,opt,name=ticketPrice,json=ticket_price,proto3" json:"ticketPrice,omitempty"`
+	Attributes    *ScreeningAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Status        *ScreeningStatusInfo `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// tickets sales period
+	SalesOpenAt  *Timestamp `protobuf:"bytes,9,opt,name=salesOpenAt,json=sales_open_at,proto3" json:"salesOpenAt,omitempty"`
+	SalesCloseAt *Timestamp `protobuf:"bytes,10,opt,name=salesCloseAt,json=sales_close_at,proto3" json:"salesCloseAt,omitempty"`
+	// tickets for the screening can be bought at the moment
+	Purchasable bool `protobuf:"varint,11,opt,name=purchasable,proto3" json:"purchasable,omitempty"`
+	// ids of the events that the screening belongs to
+	EventsIDs []int32 `protobuf:"varint,12,rep,packed,name=eventsIDs,json=events_ids,proto3" json:"eventsIDs,omitempty"`
+	// empty if the end time is unknown
+	EndTime *Timestamp `protobuf:"bytes,13,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	// empty if the screening is not enriched
+	MovieTitle string `protobuf:"bytes,14,opt,name=movieTitle,json=movie_title,proto3" json:"movieTitle,omitempty"`
+}
+
+func (x *CityScreening) Reset() {
+	*x = CityScreening{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityScreening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityScreening) ProtoMessage() {}
+
+func (x *CityScreening) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityScreening.ProtoReflect.Descriptor instead.
+func (*CityScreening) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CityScreening) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+func (x *CityScreening) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+func (x *CityScreening) GetScreeningType() string {
+	if x != nil {
+		return x.ScreeningType
+	}
+	return ""
+}
+
+func (x *CityScreening) GetStartTime() *Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CityScreening) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+func (x *CityScreening) GetTicketPrice() *Price {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return nil
+}
+
+func (x *CityScreening) GetAttributes() *ScreeningAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CityScreening) GetStatus() *ScreeningStatusInfo {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CityScreening) GetSalesOpenAt() *Timestamp {
+	if x != nil {
+		return x.SalesOpenAt
+	}
+	return nil
+}
+
+func (x *CityScreening) GetSalesCloseAt() *Timestamp {
+	if x != nil {
+		return x.SalesCloseAt
+	}
+	return nil
+}
+
+func (x *CityScreening) GetPurchasable() bool {
+	if x != nil {
+		return x.Purchasable
+	}
+	return false
+}
+
+func (x *CityScreening) GetEventsIDs() []int32 {
+	if x != nil {
+		return x.EventsIDs
+	}
+	return nil
+}
+
+func (x *CityScreening) GetEndTime() *Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CityScreening) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
+	}
+	return ""
+}
+
+type CityScreenings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Screenings []*CityScreening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+}
+
+func (x *CityScreenings) Reset() {
+	*x = CityScreenings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityScreenings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityScreenings) ProtoMessage() {}
+
+func (x *CityScreenings) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityScreenings.ProtoReflect.Descriptor instead.
+func (*CityScreenings) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CityScreenings) GetScreenings() []*CityScreening {
+	if x != nil {
+		return x.Screenings
+	}
+	return nil
+}
+
+type GetHallsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for multiple values use ',' separator
+	HallsIds string `protobuf:"bytes,1,opt,name=hallsIds,json=halls_ids,proto3" json:"hallsIds,omitempty"`
+}
+
+func (x *GetHallsRequest) Reset() {
+	*x = GetHallsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHallsRequest) ProtoMessage() {}
+
+func (x *GetHallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHallsRequest.ProtoReflect.Descriptor instead.
+func (*GetHallsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHallsRequest) GetHallsIds() string {
+	if x != nil {
+		return x.HallsIds
+	}
+	return ""
+}
+
+type GetHallConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HallID int32 `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	// if specified, places availability for the screening will be merged into the configuration
+	ScreeningID *int64 `protobuf:"varint,2,opt,name=screeningID,json=screening_id,proto3,oneof" json:"screeningID,omitempty"`
+}
+
+func (x *GetHallConfigurationRequest) Reset() {
+	*x = GetHallConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHallConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHallConfigurationRequest) ProtoMessage() {}
+
+func (x *GetHallConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHallConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*GetHallConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetHallConfigurationRequest) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+func (x *GetHallConfigurationRequest) GetScreeningID() int64 {
+	if x != nil && x.ScreeningID != nil {
+		return *x.ScreeningID
+	}
+	return 0
+}
+
+type Place struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row          int32             `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Seat         int32             `protobuf:"varint,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	GridPosX     float32           `protobuf:"fixed32,3,opt,name=grid_pos_x,json=gridPosX,proto3" json:"grid_pos_x,omitempty"`
+	GridPosY     float32           `protobuf:"fixed32,4,opt,name=grid_pos_y,json=gridPosY,proto3" json:"grid_pos_y,omitempty"`
+	Availability PlaceAvailability `protobuf:"varint,5,opt,name=availability,proto3,enum=cinema_service.PlaceAvailability" json:"availability,omitempty"`
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Place) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Place) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *Place) GetGridPosX() float32 {
+	if x != nil {
+		return x.GridPosX
+	}
+	return 0
+}
+
+func (x *Place) GetGridPosY() float32 {
+	if x != nil {
+		return x.GridPosY
+	}
+	return 0
+}
+
+func (x *Place) GetAvailability() PlaceAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return PlaceAvailability_PLACE_AVAILABILITY_UNKNOWN
+}
+
+type GetScreeningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID int64 `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+	// Fields to return, valid array values is GetScreeningResponse fields names, leave it empty if you want get all fields
+	Mask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
+	// if true, places availability will be merged into the hall configuration
+	WithAvailability bool `protobuf:"varint,3,opt,name=withAvailability,json=with_availability,proto3" json:"withAvailability,omitempty"`
+	// if true, the movie title and the end time will be received from the movie catalog
+	Enrich bool `protobuf:"varint,4,opt,name=enrich,proto3" json:"enrich,omitempty"`
+}
+
+func (x *GetScreeningRequest) Reset() {
+	*x = GetScreeningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningRequest) ProtoMessage() {}
+
+func (x *GetScreeningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningRequest.ProtoReflect.Descriptor instead.
+func (*GetScreeningRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetScreeningRequest) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+func (x *GetScreeningRequest) GetMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+func (x *GetScreeningRequest) GetWithAvailability() bool {
+	if x != nil {
+		return x.WithAvailability
+	}
+	return false
+}
+
+func (x *GetScreeningRequest) GetEnrich() bool {
+	if x != nil {
+		return x.Enrich
+	}
+	return false
+}
+
+type GetScreeningResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaID          int32                `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	MovieID           int32                `protobuf:"varint,2,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	ScreeningType     string               `protobuf:"bytes,3,opt,name=screening_type,proto3" json:"screening_type,omitempty"`
+	StartTime         *Timestamp           `protobuf:"bytes,4,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	HallID            int32                `protobuf:"varint,5,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	TicketPrice       *Price               `protobuf:"bytes,6,opt,name=ticket_price,proto3" json:"ticket_price,omitempty"`
+	HallConfiguration *HallConfiguration   `protobuf:"bytes,7,opt,name=hall_configuration,proto3" json:"hall_configuration,omitempty"`
+	Attributes        *ScreeningAttributes `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Status            *ScreeningStatusInfo `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// tickets sales period
+	SalesOpenAt  *Timestamp `protobuf:"bytes,10,opt,name=salesOpenAt,json=sales_open_at,proto3" json:"salesOpenAt,omitempty"`
+	SalesCloseAt *Timestamp `protobuf:"bytes,11,opt,name=salesCloseAt,json=sales_close_at,proto3" json:"salesCloseAt,omitempty"`
+	// tickets for the screening can be bought at the moment
+	Purchasable bool `protobuf:"varint,12,opt,name=purchasable,proto3" json:"purchasable,omitempty"`
+	// ids of the events that the screening belongs to
+	EventsIDs []int32 `protobuf:"varint,13,rep,packed,name=eventsIDs,json=events_ids,proto3" json:"eventsIDs,omitempty"`
+	// empty if the end time is unknown
+	EndTime *Timestamp `protobuf:"bytes,14,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	// empty if the screening is not enriched
+	MovieTitle string `protobuf:"bytes,15,opt,name=movieTitle,json=movie_title,proto3" json:"movieTitle,omitempty"`
+}
+
+func (x *GetScreeningResponse) Reset() {
+	*x = GetScreeningResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningResponse) ProtoMessage() {}
+
+func (x *GetScreeningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningResponse.ProtoReflect.Descriptor instead.
+func (*GetScreeningResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetScreeningResponse) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+func (x *GetScreeningResponse) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *GetScreeningResponse) GetScreeningType() string {
+	if x != nil {
+		return x.ScreeningType
+	}
+	return ""
+}
+
+func (x *GetScreeningResponse) GetStartTime() *Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+func (x *GetScreeningResponse) GetTicketPrice() *Price {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetHallConfiguration() *HallConfiguration {
+	if x != nil {
+		return x.HallConfiguration
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetAttributes() *ScreeningAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetStatus() *ScreeningStatusInfo {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetSalesOpenAt() *Timestamp {
+	if x != nil {
+		return x.SalesOpenAt
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetSalesCloseAt() *Timestamp {
+	if x != nil {
+		return x.SalesCloseAt
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetPurchasable() bool {
+	if x != nil {
+		return x.Purchasable
+	}
+	return false
+}
+
+func (x *GetScreeningResponse) GetEventsIDs() []int32 {
+	if x != nil {
+		return x.EventsIDs
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetEndTime() *Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetScreeningResponse) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
+	}
+	return ""
+}
+
+type HallConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Place       []*Place `protobuf:"bytes,1,rep,name=place,proto3" json:"place,omitempty"`
+	TotalPlaces uint32   `protobuf:"varint,2,opt,name=totalPlaces,json=total_places,proto3" json:"totalPlaces,omitempty"`
+	// number of free places, empty if availability is unknown
+	FreePlaces *uint32 `protobuf:"varint,3,opt,name=freePlaces,json=free_places,proto3,oneof" json:"freePlaces,omitempty"`
+}
+
+func (x *HallConfiguration) Reset() {
+	*x = HallConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HallConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HallConfiguration) ProtoMessage() {}
+
+func (x *HallConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HallConfiguration.ProtoReflect.Descriptor instead.
+func (*HallConfiguration) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HallConfiguration) GetPlace() []*Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *HallConfiguration) GetTotalPlaces() uint32 {
+	if x != nil {
+		return x.TotalPlaces
+	}
+	return 0
+}
+
+func (x *HallConfiguration) GetFreePlaces() uint32 {
+	if x != nil && x.FreePlaces != nil {
+		return *x.FreePlaces
+	}
+	return 0
+}
+
+type GetCinemaHalls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+}
+
+func (x *GetCinemaHalls) Reset() {
+	*x = GetCinemaHalls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCinemaHalls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCinemaHalls) ProtoMessage() {}
+
+func (x *GetCinemaHalls) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCinemaHalls.ProtoReflect.Descriptor instead.
+func (*GetCinemaHalls) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCinemaHalls) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+type UpdateScreeningStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID int64           `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+	Status      ScreeningStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cinema_service.ScreeningStatus" json:"status,omitempty"`
+	Reason      string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// required for the moved screening
+	ReplacementScreeningID *int64 `protobuf:"varint,4,opt,name=replacementScreeningID,json=replacement_screening_id,proto3,oneof" json:"replacementScreeningID,omitempty"`
+}
+
+func (x *UpdateScreeningStatusRequest) Reset() {
+	*x = UpdateScreeningStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScreeningStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScreeningStatusRequest) ProtoMessage() {}
+
+func (x *UpdateScreeningStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScreeningStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScreeningStatusRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateScreeningStatusRequest) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+func (x *UpdateScreeningStatusRequest) GetStatus() ScreeningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScreeningStatus_SCREENING_STATUS_SCHEDULED
+}
+
+func (x *UpdateScreeningStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateScreeningStatusRequest) GetReplacementScreeningID() int64 {
+	if x != nil && x.ReplacementScreeningID != nil {
+		return *x.ReplacementScreeningID
+	}
+	return 0
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityID int32 `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEventsRequest) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+// Film festival, marathon or premiere, that groups screenings
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID     int32      `protobuf:"varint,1,opt,name=eventID,json=event_id,proto3" json:"eventID,omitempty"`
+	Name        string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate   *Timestamp `protobuf:"bytes,4,opt,name=startDate,json=start_date,proto3" json:"startDate,omitempty"`
+	EndDate     *Timestamp `protobuf:"bytes,5,opt,name=endDate,json=end_date,proto3" json:"endDate,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Event) GetEventID() int32 {
+	if x != nil {
+		return x.EventID
+	}
+	return 0
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetStartDate() *Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Event) GetEndDate() *Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type Events struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *Events) Reset() {
+	*x = Events{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Events) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Events) ProtoMessage() {}
+
+func (x *Events) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Events.ProtoReflect.Descriptor instead.
+func (*Events) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Events) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetEventScreeningsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventID int32 `protobuf:"varint,1,opt,name=eventID,json=event_id,proto3" json:"eventID,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,2,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+}
+
+func (x *GetEventScreeningsRequest) Reset() {
+	*x = GetEventScreeningsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventScreeningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventScreeningsRequest) ProtoMessage() {}
+
+func (x *GetEventScreeningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventScreeningsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventScreeningsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEventScreeningsRequest) GetEventID() int32 {
+	if x != nil {
+		return x.EventID
+	}
+	return 0
+}
+
+func (x *GetEventScreeningsRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+type CreateScreeningRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovieID         int32      `protobuf:"varint,1,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	HallID          int32      `protobuf:"varint,2,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	ScreeningTypeID int32      `protobuf:"varint,3,opt,name=screeningTypeID,json=screening_type_id,proto3" json:"screeningTypeID,omitempty"`
+	StartTime       *Timestamp `protobuf:"bytes,4,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	// screening duration in minutes, if 0 the movie runtime from the movie catalog is used
+	Duration    uint32               `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	TicketPrice *Price               `protobuf:"bytes,6,opt,name=ticketPrice,json=ticket_price,proto3" json:"ticketPrice,omitempty"`
+	Attributes  *ScreeningAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *CreateScreeningRequest) Reset() {
+	*x = CreateScreeningRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScreeningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScreeningRequest) ProtoMessage() {}
+
+func (x *CreateScreeningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScreeningRequest.ProtoReflect.Descriptor instead.
+func (*CreateScreeningRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateScreeningRequest) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *CreateScreeningRequest) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+func (x *CreateScreeningRequest) GetScreeningTypeID() int32 {
+	if x != nil {
+		return x.ScreeningTypeID
+	}
+	return 0
+}
+
+func (x *CreateScreeningRequest) GetStartTime() *Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateScreeningRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateScreeningRequest) GetTicketPrice() *Price {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return nil
+}
+
+func (x *CreateScreeningRequest) GetAttributes() *ScreeningAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateScreeningResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID int64 `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+}
+
+func (x *CreateScreeningResponse) Reset() {
+	*x = CreateScreeningResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScreeningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScreeningResponse) ProtoMessage() {}
+
+func (x *CreateScreeningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScreeningResponse.ProtoReflect.Descriptor instead.
+func (*CreateScreeningResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateScreeningResponse) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+type CreateHallMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HallID    int32      `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+	StartTime *Timestamp `protobuf:"bytes,2,opt,name=startTime,json=start_time,proto3" json:"startTime,omitempty"`
+	EndTime   *Timestamp `protobuf:"bytes,3,opt,name=endTime,json=end_time,proto3" json:"endTime,omitempty"`
+	Reason    string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CreateHallMaintenanceWindowRequest) Reset() {
+	*x = CreateHallMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHallMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHallMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateHallMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHallMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateHallMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateHallMaintenanceWindowRequest) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+func (x *CreateHallMaintenanceWindowRequest) GetStartTime() *Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateHallMaintenanceWindowRequest) GetEndTime() *Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateHallMaintenanceWindowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListHallMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HallID int32 `protobuf:"varint,1,opt,name=hallID,json=hall_id,proto3" json:"hallID,omitempty"`
+}
+
+func (x *ListHallMaintenanceWindowsRequest) Reset() {
+	*x = ListHallMaintenanceWindowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHallMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHallMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListHallMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHallMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListHallMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListHallMaintenanceWindowsRequest) GetHallID() int32 {
+	if x != nil {
+		return x.HallID
+	}
+	return 0
+}
+
+type UploadCinemaPhotoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	// photo in jpeg, png or webp format
+	Photo []byte `protobuf:"bytes,2,opt,name=photo,proto3" json:"photo,omitempty"`
+}
+
+func (x *UploadCinemaPhotoRequest) Reset() {
+	*x = UploadCinemaPhotoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadCinemaPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCinemaPhotoRequest) ProtoMessage() {}
+
+func (x *UploadCinemaPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCinemaPhotoRequest.ProtoReflect.Descriptor instead.
+func (*UploadCinemaPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UploadCinemaPhotoRequest) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+func (x *UploadCinemaPhotoRequest) GetPhoto() []byte {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+type UploadCinemaPhotoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhotoUrl string `protobuf:"bytes,1,opt,name=photoUrl,json=photo_url,proto3" json:"photoUrl,omitempty"`
+}
+
+func (x *UploadCinemaPhotoResponse) Reset() {
+	*x = UploadCinemaPhotoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadCinemaPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCinemaPhotoResponse) ProtoMessage() {}
+
+func (x *UploadCinemaPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCinemaPhotoResponse.ProtoReflect.Descriptor instead.
+func (*UploadCinemaPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UploadCinemaPhotoResponse) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type ListChainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityID int32 `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+}
+
+func (x *ListChainsRequest) Reset() {
+	*x = ListChainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChainsRequest) ProtoMessage() {}
+
+func (x *ListChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChainsRequest.ProtoReflect.Descriptor instead.
+func (*ListChainsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListChainsRequest) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+type Chain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainID int32  `protobuf:"varint,1,opt,name=chainID,json=chain_id,proto3" json:"chainID,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// brands of the chain cinemas in the city
+	Brands []string `protobuf:"bytes,3,rep,name=brands,proto3" json:"brands,omitempty"`
+}
+
+func (x *Chain) Reset() {
+	*x = Chain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chain) ProtoMessage() {}
+
+func (x *Chain) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chain.ProtoReflect.Descriptor instead.
+func (*Chain) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Chain) GetChainID() int32 {
+	if x != nil {
+		return x.ChainID
+	}
+	return 0
+}
+
+func (x *Chain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chain) GetBrands() []string {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+type Chains struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chains []*Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *Chains) Reset() {
+	*x = Chains{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chains) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chains) ProtoMessage() {}
+
+func (x *Chains) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chains.ProtoReflect.Descriptor instead.
+func (*Chains) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Chains) GetChains() []*Chain {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cinema name, address or city name, may contain typos
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// if specified, searches only in the city and its cinemas
+	CityID *int32 `protobuf:"varint,2,opt,name=cityID,json=city_id,proto3,oneof" json:"cityID,omitempty"`
+	// if not specified or zero, 20 results are returned, max 100
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCityID() int32 {
+	if x != nil && x.CityID != nil {
+		return *x.CityID
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SearchResultType `protobuf:"varint,1,opt,name=type,proto3,enum=cinema_service.SearchResultType" json:"type,omitempty"`
+	// city id or cinema id, depends on the type
+	Id   int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// empty for the city
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	CityID  int32  `protobuf:"varint,5,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	// similarity of the result to the query, from 0 to 1
+	Score float32 `protobuf:"fixed32,6,opt,name=score,proto3" json:"score,omitempty"`
+	// name with the matched words wrapped into <em></em>
+	NameHighlight string `protobuf:"bytes,7,opt,name=nameHighlight,json=name_highlight,proto3" json:"nameHighlight,omitempty"`
+	// address with the matched words wrapped into <em></em>
+	AddressHighlight string `protobuf:"bytes,8,opt,name=addressHighlight,json=address_highlight,proto3" json:"addressHighlight,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SearchResult) GetType() SearchResultType {
+	if x != nil {
+		return x.Type
+	}
+	return SearchResultType_SEARCH_RESULT_TYPE_CITY
+}
+
+func (x *SearchResult) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SearchResult) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+func (x *SearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *SearchResult) GetAddressHighlight() string {
+	if x != nil {
+		return x.AddressHighlight
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AutocompleteCitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// beginning of the city name in latin or cyrillic
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// if not specified or zero, 10 cities are returned, max 100
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AutocompleteCitiesRequest) Reset() {
+	*x = AutocompleteCitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutocompleteCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteCitiesRequest) ProtoMessage() {}
+
+func (x *AutocompleteCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteCitiesRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AutocompleteCitiesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *AutocompleteCitiesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CitySuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityID     int32  `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	HasCinemas bool   `protobuf:"varint,3,opt,name=hasCinemas,json=has_cinemas,proto3" json:"hasCinemas,omitempty"`
+}
+
+func (x *CitySuggestion) Reset() {
+	*x = CitySuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitySuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitySuggestion) ProtoMessage() {}
+
+func (x *CitySuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitySuggestion.ProtoReflect.Descriptor instead.
+func (*CitySuggestion) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CitySuggestion) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+func (x *CitySuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CitySuggestion) GetHasCinemas() bool {
+	if x != nil {
+		return x.HasCinemas
+	}
+	return false
+}
+
+type CitiesSuggestions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cities []*CitySuggestion `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+}
+
+func (x *CitiesSuggestions) Reset() {
+	*x = CitiesSuggestions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CitiesSuggestions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitiesSuggestions) ProtoMessage() {}
+
+func (x *CitiesSuggestions) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitiesSuggestions.ProtoReflect.Descriptor instead.
+func (*CitiesSuggestions) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CitiesSuggestions) GetCities() []*CitySuggestion {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type GetCinemasInBoundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLat float64 `protobuf:"fixed64,1,opt,name=minLat,json=min_lat,proto3" json:"minLat,omitempty"`
+	MinLon float64 `protobuf:"fixed64,2,opt,name=minLon,json=min_lon,proto3" json:"minLon,omitempty"`
+	MaxLat float64 `protobuf:"fixed64,3,opt,name=maxLat,json=max_lat,proto3" json:"maxLat,omitempty"`
+	MaxLon float64 `protobuf:"fixed64,4,opt,name=maxLon,json=max_lon,proto3" json:"maxLon,omitempty"`
+	// map zoom level from 0 to 22, cinemas are clustered if zoom is less than 12
+	Zoom uint32 `protobuf:"varint,5,opt,name=zoom,proto3" json:"zoom,omitempty"`
+	// only cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,6,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+	// amenities tags, cinemas must have all specified amenities, for multiple values use ',' as separator
+	Amenities *string `protobuf:"bytes,7,opt,name=amenities,proto3,oneof" json:"amenities,omitempty"`
+}
+
+func (x *GetCinemasInBoundsRequest) Reset() {
+	*x = GetCinemasInBoundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCinemasInBoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCinemasInBoundsRequest) ProtoMessage() {}
+
+func (x *GetCinemasInBoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCinemasInBoundsRequest.ProtoReflect.Descriptor instead.
+func (*GetCinemasInBoundsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCinemasInBoundsRequest) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *GetCinemasInBoundsRequest) GetMinLon() float64 {
+	if x != nil {
+		return x.MinLon
+	}
+	return 0
+}
+
+func (x *GetCinemasInBoundsRequest) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *GetCinemasInBoundsRequest) GetMaxLon() float64 {
+	if x != nil {
+		return x.MaxLon
+	}
+	return 0
+}
+
+func (x *GetCinemasInBoundsRequest) GetZoom() uint32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *GetCinemasInBoundsRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+func (x *GetCinemasInBoundsRequest) GetAmenities() string {
+	if x != nil && x.Amenities != nil {
+		return *x.Amenities
+	}
+	return ""
+}
+
+type CinemasCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Centroid     *Coordinates `protobuf:"bytes,1,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	CinemasCount uint32       `protobuf:"varint,2,opt,name=cinemasCount,json=cinemas_count,proto3" json:"cinemasCount,omitempty"`
+}
+
+func (x *CinemasCluster) Reset() {
+	*x = CinemasCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CinemasCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemasCluster) ProtoMessage() {}
+
+func (x *CinemasCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemasCluster.ProtoReflect.Descriptor instead.
+func (*CinemasCluster) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CinemasCluster) GetCentroid() *Coordinates {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *CinemasCluster) GetCinemasCount() uint32 {
+	if x != nil {
+		return x.CinemasCount
+	}
+	return 0
+}
+
+type CinemasInBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not empty if the cinemas are not clustered
+	Cinemas []*Cinema `protobuf:"bytes,1,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	// not empty if the cinemas are clustered
+	Clusters []*CinemasCluster `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *CinemasInBounds) Reset() {
+	*x = CinemasInBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CinemasInBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemasInBounds) ProtoMessage() {}
+
+func (x *CinemasInBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemasInBounds.ProtoReflect.Descriptor instead.
+func (*CinemasInBounds) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CinemasInBounds) GetCinemas() []*Cinema {
+	if x != nil {
+		return x.Cinemas
+	}
+	return nil
+}
+
+func (x *CinemasInBounds) GetClusters() []*CinemasCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type ResolveCityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon float64 `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (x *ResolveCityRequest) Reset() {
+	*x = ResolveCityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCityRequest) ProtoMessage() {}
+
+func (x *ResolveCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCityRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCityRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResolveCityRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *ResolveCityRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type ResolveCityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City *City `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	// true if the point is inside the city boundary, false if the city is the city of the nearest cinema
+	ByBoundary bool `protobuf:"varint,2,opt,name=byBoundary,json=by_boundary,proto3" json:"byBoundary,omitempty"`
+}
+
+func (x *ResolveCityResponse) Reset() {
+	*x = ResolveCityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCityResponse) ProtoMessage() {}
+
+func (x *ResolveCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCityResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCityResponse) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResolveCityResponse) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *ResolveCityResponse) GetByBoundary() bool {
+	if x != nil {
+		return x.ByBoundary
+	}
+	return false
+}
+
+type Country struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryID int32  `protobuf:"varint,1,opt,name=countryID,json=country_id,proto3" json:"countryID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ISO 3166-1 alpha-2 code
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// default ISO 4217 currency code of the country cities
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// default IANA time zone name of the country cities
+	TimeZone string `protobuf:"bytes,5,opt,name=timeZone,json=time_zone,proto3" json:"timeZone,omitempty"`
+	// default ISO 639-1 locale of the country cities
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Country) GetCountryID() int32 {
+	if x != nil {
+		return x.CountryID
+	}
+	return 0
+}
+
+func (x *Country) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Country) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Country) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Country) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Country) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type Countries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Countries []*Country `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+}
+
+func (x *Countries) Reset() {
+	*x = Countries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Countries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Countries) ProtoMessage() {}
+
+func (x *Countries) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Countries.ProtoReflect.Descriptor instead.
+func (*Countries) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Countries) GetCountries() []*Country {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CountryID int32 `protobuf:"varint,1,opt,name=countryID,json=country_id,proto3" json:"countryID,omitempty"`
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListRegionsRequest) GetCountryID() int32 {
+	if x != nil {
+		return x.CountryID
+	}
+	return 0
+}
+
+type Region struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegionID  int32  `protobuf:"varint,1,opt,name=regionID,json=region_id,proto3" json:"regionID,omitempty"`
+	CountryID int32  `protobuf:"varint,2,opt,name=countryID,json=country_id,proto3" json:"countryID,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Region) GetRegionID() int32 {
+	if x != nil {
+		return x.RegionID
+	}
+	return 0
+}
+
+func (x *Region) GetCountryID() int32 {
+	if x != nil {
+		return x.CountryID
+	}
+	return 0
+}
+
+func (x *Region) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Regions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regions []*Region `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *Regions) Reset() {
+	*x = Regions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Regions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Regions) ProtoMessage() {}
+
+func (x *Regions) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Regions.ProtoReflect.Descriptor instead.
+func (*Regions) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Regions) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type GetRegionCitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RegionID int32 `protobuf:"varint,1,opt,name=regionID,json=region_id,proto3" json:"regionID,omitempty"`
+}
+
+func (x *GetRegionCitiesRequest) Reset() {
+	*x = GetRegionCitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegionCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionCitiesRequest) ProtoMessage() {}
+
+func (x *GetRegionCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionCitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetRegionCitiesRequest) GetRegionID() int32 {
+	if x != nil {
+		return x.RegionID
+	}
+	return 0
+}
+
+type GetCityMoviesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityID int32 `protobuf:"varint,1,opt,name=cityID,json=city_id,proto3" json:"cityID,omitempty"`
+	// the now showing horizon in days, if not specified or zero, 7 days is used, max 60
+	Days uint32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,3,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+}
+
+func (x *GetCityMoviesRequest) Reset() {
+	*x = GetCityMoviesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCityMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCityMoviesRequest) ProtoMessage() {}
+
+func (x *GetCityMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCityMoviesRequest.ProtoReflect.Descriptor instead.
+func (*GetCityMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCityMoviesRequest) GetCityID() int32 {
+	if x != nil {
+		return x.CityID
+	}
+	return 0
+}
+
+func (x *GetCityMoviesRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetCityMoviesRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+type CityMovie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovieID int32 `protobuf:"varint,1,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	// start time of the nearest screening in the city
+	FirstScreeningTime *Timestamp `protobuf:"bytes,2,opt,name=firstScreeningTime,json=first_screening_time,proto3" json:"firstScreeningTime,omitempty"`
+}
+
+func (x *CityMovie) Reset() {
+	*x = CityMovie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityMovie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityMovie) ProtoMessage() {}
+
+func (x *CityMovie) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityMovie.ProtoReflect.Descriptor instead.
+func (*CityMovie) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CityMovie) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *CityMovie) GetFirstScreeningTime() *Timestamp {
+	if x != nil {
+		return x.FirstScreeningTime
+	}
+	return nil
+}
+
+type CityMovies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movies []*CityMovie `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+}
+
+func (x *CityMovies) Reset() {
+	*x = CityMovies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CityMovies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityMovies) ProtoMessage() {}
+
+func (x *CityMovies) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityMovies.ProtoReflect.Descriptor instead.
+func (*CityMovies) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CityMovies) GetMovies() []*CityMovie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+type GetCinemaUpcomingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CinemaID int32 `protobuf:"varint,1,opt,name=cinemaID,json=cinema_id,proto3" json:"cinemaID,omitempty"`
+	// max number of the screenings for each movie, if not specified or zero, 5 is used, max 20
+	PerMovieLimit uint32 `protobuf:"varint,2,opt,name=perMovieLimit,json=per_movie_limit,proto3" json:"perMovieLimit,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,3,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+}
+
+func (x *GetCinemaUpcomingRequest) Reset() {
+	*x = GetCinemaUpcomingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCinemaUpcomingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCinemaUpcomingRequest) ProtoMessage() {}
+
+func (x *GetCinemaUpcomingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCinemaUpcomingRequest.ProtoReflect.Descriptor instead.
+func (*GetCinemaUpcomingRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCinemaUpcomingRequest) GetCinemaID() int32 {
+	if x != nil {
+		return x.CinemaID
+	}
+	return 0
+}
+
+func (x *GetCinemaUpcomingRequest) GetPerMovieLimit() uint32 {
+	if x != nil {
+		return x.PerMovieLimit
+	}
+	return 0
+}
+
+func (x *GetCinemaUpcomingRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+type MovieUpcomingScreenings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovieID int32 `protobuf:"varint,1,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	// next screenings ordered by the start time
+	Screenings []*Screening `protobuf:"bytes,2,rep,name=screenings,proto3" json:"screenings,omitempty"`
+}
+
+func (x *MovieUpcomingScreenings) Reset() {
+	*x = MovieUpcomingScreenings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovieUpcomingScreenings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieUpcomingScreenings) ProtoMessage() {}
+
+func (x *MovieUpcomingScreenings) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieUpcomingScreenings.ProtoReflect.Descriptor instead.
+func (*MovieUpcomingScreenings) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MovieUpcomingScreenings) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *MovieUpcomingScreenings) GetScreenings() []*Screening {
+	if x != nil {
+		return x.Screenings
+	}
+	return nil
+}
+
+// Movies ordered by the nearest screening
+type MoviesUpcomingScreenings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movies []*MovieUpcomingScreenings `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+}
+
+func (x *MoviesUpcomingScreenings) Reset() {
+	*x = MoviesUpcomingScreenings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoviesUpcomingScreenings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoviesUpcomingScreenings) ProtoMessage() {}
+
+func (x *MoviesUpcomingScreenings) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoviesUpcomingScreenings.ProtoReflect.Descriptor instead.
+func (*MoviesUpcomingScreenings) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *MoviesUpcomingScreenings) GetMovies() []*MovieUpcomingScreenings {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+type GetAlternativesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreeningID int64 `protobuf:"varint,1,opt,name=screeningID,json=screening_id,proto3" json:"screeningID,omitempty"`
+	// if not specified or zero, 10 alternatives are returned, max 50
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// only screenings in the cinemas of the specified chains, for multiple values use ',' as separator
+	ChainsIds *string `protobuf:"bytes,3,opt,name=chainsIds,json=chains_ids,proto3,oneof" json:"chainsIds,omitempty"`
+}
+
+func (x *GetAlternativesRequest) Reset() {
+	*x = GetAlternativesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAlternativesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlternativesRequest) ProtoMessage() {}
+
+func (x *GetAlternativesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlternativesRequest.ProtoReflect.Descriptor instead.
+func (*GetAlternativesRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetAlternativesRequest) GetScreeningID() int64 {
+	if x != nil {
+		return x.ScreeningID
+	}
+	return 0
+}
+
+func (x *GetAlternativesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAlternativesRequest) GetChainsIds() string {
+	if x != nil && x.ChainsIds != nil {
+		return *x.ChainsIds
+	}
+	return ""
+}
+
+type AlternativeScreening struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Screening *CityScreening `protobuf:"bytes,1,opt,name=screening,proto3" json:"screening,omitempty"`
+	// distance between the cinemas in meters, 0 for the same cinema
+	Distance          float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	SameScreeningType bool    `protobuf:"varint,3,opt,name=sameScreeningType,json=same_screening_type,proto3" json:"sameScreeningType,omitempty"`
+}
+
+func (x *AlternativeScreening) Reset() {
+	*x = AlternativeScreening{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlternativeScreening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternativeScreening) ProtoMessage() {}
+
+func (x *AlternativeScreening) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternativeScreening.ProtoReflect.Descriptor instead.
+func (*AlternativeScreening) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AlternativeScreening) GetScreening() *CityScreening {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *AlternativeScreening) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *AlternativeScreening) GetSameScreeningType() bool {
+	if x != nil {
+		return x.SameScreeningType
+	}
+	return false
+}
+
+// Screenings in the same cinema go first, then in the nearest cinemas
+type AlternativeScreenings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Screenings []*AlternativeScreening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+}
+
+func (x *AlternativeScreenings) Reset() {
+	*x = AlternativeScreenings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlternativeScreenings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternativeScreenings) ProtoMessage() {}
+
+func (x *AlternativeScreenings) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternativeScreenings.ProtoReflect.Descriptor instead.
+func (*AlternativeScreenings) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AlternativeScreenings) GetScreenings() []*AlternativeScreening {
+	if x != nil {
+		return x.Screenings
+	}
+	return nil
+}
+
+type GetCinemasByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for multiple values use ',' separator, maximum 100 ids
+	CinemasIds string `protobuf:"bytes,1,opt,name=cinemasIds,json=cinemas_ids,proto3" json:"cinemasIds,omitempty"`
+}
+
+func (x *GetCinemasByIdsRequest) Reset() {
+	*x = GetCinemasByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCinemasByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCinemasByIdsRequest) ProtoMessage() {}
+
+func (x *GetCinemasByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCinemasByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetCinemasByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCinemasByIdsRequest) GetCinemasIds() string {
+	if x != nil {
+		return x.CinemasIds
+	}
+	return ""
+}
+
+type CinemasByIds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// found cinemas in the order of the requested ids
+	Cinemas    []*Cinema `protobuf:"bytes,1,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	MissingIds []int32   `protobuf:"varint,2,rep,packed,name=missingIds,json=missing_ids,proto3" json:"missingIds,omitempty"`
+}
+
+func (x *CinemasByIds) Reset() {
+	*x = CinemasByIds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CinemasByIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemasByIds) ProtoMessage() {}
+
+func (x *CinemasByIds) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemasByIds.ProtoReflect.Descriptor instead.
+func (*CinemasByIds) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CinemasByIds) GetCinemas() []*Cinema {
+	if x != nil {
+		return x.Cinemas
+	}
+	return nil
+}
+
+func (x *CinemasByIds) GetMissingIds() []int32 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type GetScreeningsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for multiple values use ',' separator, maximum 100 ids
+	ScreeningsIds string `protobuf:"bytes,1,opt,name=screeningsIds,json=screenings_ids,proto3" json:"screeningsIds,omitempty"`
+	// if true, the movie title and the end time will be received from the movie catalog
+	Enrich bool `protobuf:"varint,2,opt,name=enrich,proto3" json:"enrich,omitempty"`
+}
+
+func (x *GetScreeningsByIdsRequest) Reset() {
+	*x = GetScreeningsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScreeningsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScreeningsByIdsRequest) ProtoMessage() {}
+
+func (x *GetScreeningsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScreeningsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetScreeningsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetScreeningsByIdsRequest) GetScreeningsIds() string {
+	if x != nil {
+		return x.ScreeningsIds
+	}
+	return ""
+}
+
+func (x *GetScreeningsByIdsRequest) GetEnrich() bool {
+	if x != nil {
+		return x.Enrich
+	}
+	return false
+}
+
+type ScreeningsByIds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// found screenings in the order of the requested ids
+	Screenings []*Screening `protobuf:"bytes,1,rep,name=screenings,proto3" json:"screenings,omitempty"`
+	MissingIds []int64      `protobuf:"varint,2,rep,packed,name=missingIds,json=missing_ids,proto3" json:"missingIds,omitempty"`
+}
+
+func (x *ScreeningsByIds) Reset() {
+	*x = ScreeningsByIds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cinema_service_v1_messages_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScreeningsByIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningsByIds) ProtoMessage() {}
+
+func (x *ScreeningsByIds) ProtoReflect() protoreflect.Message {
+	mi := &file_cinema_service_v1_messages_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningsByIds.ProtoReflect.Descriptor instead.
+func (*ScreeningsByIds) Descriptor() ([]byte, []int) {
+	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ScreeningsByIds) GetScreenings() []*Screening {
+	if x != nil {
+		return x.Screenings
+	}
+	return nil
+}
+
+func (x *ScreeningsByIds) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+var File_cinema_service_v1_messages_proto protoreflect.FileDescriptor
+
+var file_cinema_service_v1_messages_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2f, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0xe2, 0x05, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x3c,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x11, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x70,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x12, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x10, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x61, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x0b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x2b, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0f,
+	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x68, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0xd9, 0x06, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49,
+	0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x11, 0x68, 0x61,
+	0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c,
+	0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x11,
+	0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x12, 0x73, 0x75, 0x62, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a,
+	0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0e, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x0a, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x68, 0x61, 0x6c,
+	0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x49, 0x64, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x49, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0a, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3a,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x14, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0c, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x11, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x65, 0x61, 0x72, 0x6c, 0x69,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x45,
+	0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a,
+	0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x11,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x8f, 0x06, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76,
+	0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x5f, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x11,
+	0x68, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x73,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32,
+	0x0a, 0x11, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x12, 0x73, 0x75, 0x62,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x2b, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0e,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65, 0x64, 0x12,
+	0x24, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x22, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x68, 0x61,
+	0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0e, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x78, 0x65,
+	0x64, 0x22, 0xfa, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0xb4,
+	0x05, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0b,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x06, 0x68,
+	0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x43,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x12,
+	0x3f, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0e, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc9,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x6e, 0x43,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79,
+	0x5f, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x61, 0x6d,
+	0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x79, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x79, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x22, 0xfe, 0x03, 0x0a, 0x06, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x12, 0x1b,
+	0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x4e, 0x6f, 0x77, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6e, 0x6f, 0x77, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0a,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x55, 0x72, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x19, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x22, 0x60, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x19,
+	0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x43, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x3b, 0x0a, 0x07, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x22, 0xa1, 0x01,
+	0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0x36, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x63,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74,
+	0x79, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x14, 0x48, 0x61, 0x6c,
+	0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0xb2, 0x03, 0x0a, 0x04, 0x48, 0x61,
+	0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x68,
+	0x61, 0x6c, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68,
+	0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x27, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48,
+	0x61, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64,
+	0x12, 0x44, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa4,
+	0x02, 0x0a, 0x15, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x06,
+	0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x15, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x17, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12,
+	0x3f, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x22, 0x33, 0x0a, 0x05, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x68, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x52, 0x05,
+	0x68, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x49, 0x44, 0x22, 0x91, 0x06, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x32, 0x0a, 0x11, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x68,
+	0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0e, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0f,
+	0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x32, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x12, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c,
+	0x61, 0x78, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61,
+	0x78, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x70, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x73, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0x9d, 0x05, 0x0a, 0x0d, 0x43, 0x69,
+	0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0b, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x06,
+	0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74,
+	0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x0e, 0x43, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x08, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x6e, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c,
+	0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f,
+	0x69, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0xb0, 0x01, 0x0a, 0x05, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x72,
+	0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x67, 0x72, 0x69, 0x64, 0x50, 0x6f, 0x73, 0x58, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x72, 0x69, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x67, 0x72,
+	0x69, 0x64, 0x50, 0x6f, 0x73, 0x59, 0x12, 0x45, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xad, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x2b, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x22, 0xf2, 0x05,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x61,
+	0x6c, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x61, 0x6c, 0x65,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x61, 0x6c,
+	0x65, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x73, 0x61, 0x6c, 0x65,
+	0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x05,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0a, 0x66, 0x72, 0x65, 0x65,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b,
+	0x66, 0x72, 0x65, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x2d, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x22, 0xec, 0x01, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x3d, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x68, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68,
+	0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06,
+	0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3c, 0x0a,
+	0x21, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x18, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a, 0x19, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x5f, 0x75, 0x72, 0x6c, 0x22, 0x2c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74,
+	0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x22, 0x4e, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x73, 0x22, 0x37, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x0d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x1c, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x22, 0x85, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x10,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5e,
+	0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x68, 0x61, 0x73, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x4b,
+	0x0a, 0x11, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x6e, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x6d, 0x69, 0x6e,
+	0x4c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
+	0x61, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x4c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x7a, 0x6f, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x7a, 0x6f, 0x6f,
+	0x6d, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x61, 0x6d, 0x65, 0x6e,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x6d, 0x65, 0x6e, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x0c, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49,
+	0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
+	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x52, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x69,
+	0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22,
+	0x60, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x79, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x22, 0xa1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x42, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x58,
+	0x0a, 0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x49, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x09, 0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x14, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x0a, 0x43, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x52, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x5f,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0x6f, 0x0a,
+	0x17, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x5b,
+	0x0a, 0x18, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x6d, 0x6f,
+	0x76, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x69, 0x6e,
+	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x55, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x22, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x49, 0x64,
+	0x73, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x11, 0x73, 0x61, 0x6d, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x73, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x39, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73,
+	0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0a,
+	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a,
+	0x0c, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12,
+	0x1f, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73,
+	0x22, 0x5a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x22, 0x6d, 0x0a, 0x0f,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x2a, 0xac, 0x01, 0x0a, 0x0f,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x50, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53,
+	0x43, 0x52, 0x45, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x53, 0x4f, 0x4c, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0x5c, 0x0a, 0x10, 0x48, 0x61,
+	0x6c, 0x6c, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f,
+	0x0a, 0x1b, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x27, 0x0a, 0x23, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54,
+	0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x2a, 0x71, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a,
+	0x1a, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4c,
+	0x41, 0x43, 0x45, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x49, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x10, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x49, 0x4e, 0x45, 0x4d, 0x41, 0x10, 0x01, 0x42, 0x1a, 0x5a, 0x18, 0x63,
+	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cinema_service_v1_messages_proto_rawDescOnce sync.Once
+	file_cinema_service_v1_messages_proto_rawDescData = file_cinema_service_v1_messages_proto_rawDesc
+)
+
+func file_cinema_service_v1_messages_proto_rawDescGZIP() []byte {
+	file_cinema_service_v1_messages_proto_rawDescOnce.Do(func() {
+		file_cinema_service_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_cinema_service_v1_messages_proto_rawDescData)
+	})
+	return file_cinema_service_v1_messages_proto_rawDescData
+}
+
+var file_cinema_service_v1_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cinema_service_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_cinema_service_v1_messages_proto_goTypes = []interface{}{
+	(ScreeningStatus)(0),                       // 0: cinema_service.ScreeningStatus
+	(HallAvailability)(0),                      // 1: cinema_service.HallAvailability
+	(PlaceAvailability)(0),                     // 2: cinema_service.PlaceAvailability
+	(SearchResultType)(0),                      // 3: cinema_service.SearchResultType
+	(*Timestamp)(nil),                          // 4: cinema_service.Timestamp
+	(*GetMoviesScreeningsRequest)(nil),         // 5: cinema_service.GetMoviesScreeningsRequest
+	(*GetMoviesScreeningsInCitiesRequest)(nil), // 6: cinema_service.GetMoviesScreeningsInCitiesRequest
+	(*Price)(nil),                              // 7: cinema_service.Price
+	(*PreviewScreening)(nil),                   // 8: cinema_service.PreviewScreening
+	(*MovieScreeningsStats)(nil),               // 9: cinema_service.MovieScreeningsStats
+	(*PreviewScreenings)(nil),                  // 10: cinema_service.PreviewScreenings
+	(*GetScreeningsRequest)(nil),               // 11: cinema_service.GetScreeningsRequest
+	(*ScreeningAttributes)(nil),                // 12: cinema_service.ScreeningAttributes
+	(*ScreeningStatusInfo)(nil),                // 13: cinema_service.ScreeningStatusInfo
+	(*Screening)(nil),                          // 14: cinema_service.Screening
+	(*Screenings)(nil),                         // 15: cinema_service.Screenings
+	(*GetCinemasInCityRequest)(nil),            // 16: cinema_service.GetCinemasInCityRequest
+	(*Coordinates)(nil),                        // 17: cinema_service.Coordinates
+	(*Cinema)(nil),                             // 18: cinema_service.Cinema
+	(*OpeningHours)(nil),                       // 19: cinema_service.OpeningHours
+	(*CinemaClosure)(nil),                      // 20: cinema_service.CinemaClosure
+	(*Cinemas)(nil),                            // 21: cinema_service.Cinemas
+	(*City)(nil),                               // 22: cinema_service.City
+	(*Cities)(nil),                             // 23: cinema_service.Cities
+	(*HallCategoryCapacity)(nil),               // 24: cinema_service.HallCategoryCapacity
+	(*Hall)(nil),                               // 25: cinema_service.Hall
+	(*HallMaintenanceWindow)(nil),              // 26: cinema_service.HallMaintenanceWindow
+	(*HallMaintenanceWindows)(nil),             // 27: cinema_service.HallMaintenanceWindows
+	(*Halls)(nil),                              // 28: cinema_service.Halls
+	(*GetCinemaRequest)(nil),                   // 29: cinema_service.GetCinemaRequest
+	(*GetScreeningsInCityRequest)(nil),         // 30: cinema_service.GetScreeningsInCityRequest
+	(*CityScreening)(nil),                      // 31: cinema_service.CityScreening
+	(*CityScreenings)(nil),                     // 32: cinema_service.CityScreenings
+	(*GetHallsRequest)(nil),                    // 33: cinema_service.GetHallsRequest
+	(*GetHallConfigurationRequest)(nil),        // 34: cinema_service.GetHallConfigurationRequest
+	(*Place)(nil),                              // 35: cinema_service.Place
+	(*GetScreeningRequest)(nil),                // 36: cinema_service.GetScreeningRequest
+	(*GetScreeningResponse)(nil),               // 37: cinema_service.GetScreeningResponse
+	(*HallConfiguration)(nil),                  // 38: cinema_service.HallConfiguration
+	(*GetCinemaHalls)(nil),                     // 39: cinema_service.GetCinemaHalls
+	(*UpdateScreeningStatusRequest)(nil),       // 40: cinema_service.UpdateScreeningStatusRequest
+	(*ListEventsRequest)(nil),                  // 41: cinema_service.ListEventsRequest
+	(*Event)(nil),                              // 42: cinema_service.Event
+	(*Events)(nil),                             // 43: cinema_service.Events
+	(*GetEventScreeningsRequest)(nil),          // 44: cinema_service.GetEventScreeningsRequest
+	(*CreateScreeningRequest)(nil),             // 45: cinema_service.CreateScreeningRequest
+	(*CreateScreeningResponse)(nil),            // 46: cinema_service.CreateScreeningResponse
+	(*CreateHallMaintenanceWindowRequest)(nil), // 47: cinema_service.CreateHallMaintenanceWindowRequest
+	(*ListHallMaintenanceWindowsRequest)(nil),  // 48: cinema_service.ListHallMaintenanceWindowsRequest
+	(*UploadCinemaPhotoRequest)(nil),           // 49: cinema_service.UploadCinemaPhotoRequest
+	(*UploadCinemaPhotoResponse)(nil),          // 50: cinema_service.UploadCinemaPhotoResponse
+	(*ListChainsRequest)(nil),                  // 51: cinema_service.ListChainsRequest
+	(*Chain)(nil),                              // 52: cinema_service.Chain
+	(*Chains)(nil),                             // 53: cinema_service.Chains
+	(*SearchRequest)(nil),                      // 54: cinema_service.SearchRequest
+	(*SearchResult)(nil),                       // 55: cinema_service.SearchResult
+	(*SearchResponse)(nil),                     // 56: cinema_service.SearchResponse
+	(*AutocompleteCitiesRequest)(nil),          // 57: cinema_service.AutocompleteCitiesRequest
+	(*CitySuggestion)(nil),                     // 58: cinema_service.CitySuggestion
+	(*CitiesSuggestions)(nil),                  // 59: cinema_service.CitiesSuggestions
+	(*GetCinemasInBoundsRequest)(nil),          // 60: cinema_service.GetCinemasInBoundsRequest
+	(*CinemasCluster)(nil),                     // 61: cinema_service.CinemasCluster
+	(*CinemasInBounds)(nil),                    // 62: cinema_service.CinemasInBounds
+	(*ResolveCityRequest)(nil),                 // 63: cinema_service.ResolveCityRequest
+	(*ResolveCityResponse)(nil),                // 64: cinema_service.ResolveCityResponse
+	(*Country)(nil),                            // 65: cinema_service.Country
+	(*Countries)(nil),                          // 66: cinema_service.Countries
+	(*ListRegionsRequest)(nil),                 // 67: cinema_service.ListRegionsRequest
+	(*Region)(nil),                             // 68: cinema_service.Region
+	(*Regions)(nil),                            // 69: cinema_service.Regions
+	(*GetRegionCitiesRequest)(nil),             // 70: cinema_service.GetRegionCitiesRequest
+	(*GetCityMoviesRequest)(nil),               // 71: cinema_service.GetCityMoviesRequest
+	(*CityMovie)(nil),                          // 72: cinema_service.CityMovie
+	(*CityMovies)(nil),                         // 73: cinema_service.CityMovies
+	(*GetCinemaUpcomingRequest)(nil),           // 74: cinema_service.GetCinemaUpcomingRequest
+	(*MovieUpcomingScreenings)(nil),            // 75: cinema_service.MovieUpcomingScreenings
+	(*MoviesUpcomingScreenings)(nil),           // 76: cinema_service.MoviesUpcomingScreenings
+	(*GetAlternativesRequest)(nil),             // 77: cinema_service.GetAlternativesRequest
+	(*AlternativeScreening)(nil),               // 78: cinema_service.AlternativeScreening
+	(*AlternativeScreenings)(nil),              // 79: cinema_service.AlternativeScreenings
+	(*GetCinemasByIdsRequest)(nil),             // 80: cinema_service.GetCinemasByIdsRequest
+	(*CinemasByIds)(nil),                       // 81: cinema_service.CinemasByIds
+	(*GetScreeningsByIdsRequest)(nil),          // 82: cinema_service.GetScreeningsByIdsRequest
+	(*ScreeningsByIds)(nil),                    // 83: cinema_service.ScreeningsByIds
+	(*fieldmaskpb.FieldMask)(nil),              // 84: google.protobuf.FieldMask
+}
+var file_cinema_service_v1_messages_proto_depIdxs = []int32{
+	4,  // 0: cinema_service.GetMoviesScreeningsRequest.startPeriod:type_name -> cinema_service.Timestamp
+	4,  // 1: cinema_service.GetMoviesScreeningsRequest.endPeriod:type_name -> cinema_service.Timestamp
+	4,  // 2: cinema_service.GetMoviesScreeningsInCitiesRequest.startPeriod:type_name -> cinema_service.Timestamp
+	4,  // 3: cinema_service.GetMoviesScreeningsInCitiesRequest.endPeriod:type_name -> cinema_service.Timestamp
+	9,  // 4: cinema_service.PreviewScreening.stats:type_name -> cinema_service.MovieScreeningsStats
+	4,  // 5: cinema_service.MovieScreeningsStats.earliestStartTime:type_name -> cinema_service.Timestamp
+	4,  // 6: cinema_service.MovieScreeningsStats.latestStartTime:type_name -> cinema_service.Timestamp
+	7,  // 7: cinema_service.MovieScreeningsStats.minPrice:type_name -> cinema_service.Price
+	7,  // 8: cinema_service.MovieScreeningsStats.maxPrice:type_name -> cinema_service.Price
+	8,  // 9: cinema_service.PreviewScreenings.screenings:type_name -> cinema_service.PreviewScreening
+	4,  // 10: cinema_service.GetScreeningsRequest.startPeriod:type_name -> cinema_service.Timestamp
+	4,  // 11: cinema_service.GetScreeningsRequest.endPeriod:type_name -> cinema_service.Timestamp
+	0,  // 12: cinema_service.ScreeningStatusInfo.status:type_name -> cinema_service.ScreeningStatus
+	4,  // 13: cinema_service.ScreeningStatusInfo.changedAt:type_name -> cinema_service.Timestamp
+	4,  // 14: cinema_service.Screening.startTime:type_name -> cinema_service.Timestamp
+	7,  // 15: cinema_service.Screening.ticketPrice:type_name -> cinema_service.Price
+	12, // 16: cinema_service.Screening.attributes:type_name -> cinema_service.ScreeningAttributes
+	13, // 17: cinema_service.Screening.status:type_name -> cinema_service.ScreeningStatusInfo
+	4,  // 18: cinema_service.Screening.salesOpenAt:type_name -> cinema_service.Timestamp
+	4,  // 19: cinema_service.Screening.salesCloseAt:type_name -> cinema_service.Timestamp
+	4,  // 20: cinema_service.Screening.endTime:type_name -> cinema_service.Timestamp
+	14, // 21: cinema_service.Screenings.screenings:type_name -> cinema_service.Screening
+	4,  // 22: cinema_service.GetCinemasInCityRequest.openAt:type_name -> cinema_service.Timestamp
+	17, // 23: cinema_service.Cinema.coordinates:type_name -> cinema_service.Coordinates
+	19, // 24: cinema_service.Cinema.openingHours:type_name -> cinema_service.OpeningHours
+	20, // 25: cinema_service.Cinema.closures:type_name -> cinema_service.CinemaClosure
+	4,  // 26: cinema_service.CinemaClosure.startTime:type_name -> cinema_service.Timestamp
+	4,  // 27: cinema_service.CinemaClosure.endTime:type_name -> cinema_service.Timestamp
+	18, // 28: cinema_service.Cinemas.cinemas:type_name -> cinema_service.Cinema
+	22, // 29: cinema_service.Cities.cities:type_name -> cinema_service.City
+	24, // 30: cinema_service.Hall.categories:type_name -> cinema_service.HallCategoryCapacity
+	1,  // 31: cinema_service.Hall.availability:type_name -> cinema_service.HallAvailability
+	26, // 32: cinema_service.Hall.currentMaintenance:type_name -> cinema_service.HallMaintenanceWindow
+	4,  // 33: cinema_service.HallMaintenanceWindow.startTime:type_name -> cinema_service.Timestamp
+	4,  // 34: cinema_service.HallMaintenanceWindow.endTime:type_name -> cinema_service.Timestamp
+	26, // 35: cinema_service.HallMaintenanceWindows.windows:type_name -> cinema_service.HallMaintenanceWindow
+	25, // 36: cinema_service.Halls.halls:type_name -> cinema_service.Hall
+	4,  // 37: cinema_service.GetScreeningsInCityRequest.startPeriod:type_name -> cinema_service.Timestamp
+	4,  // 38: cinema_service.GetScreeningsInCityRequest.endPeriod:type_name -> cinema_service.Timestamp
+	4,  // 39: cinema_service.CityScreening.startTime:type_name -> cinema_service.Timestamp
+	7,  // 40: cinema_service.CityScreening.ticketPrice:type_name -> cinema_service.Price
+	12, // 41: cinema_service.CityScreening.attributes:type_name -> cinema_service.ScreeningAttributes
+	13, // 42: cinema_service.CityScreening.status:type_name -> cinema_service.ScreeningStatusInfo
+	4,  // 43: cinema_service.CityScreening.salesOpenAt:type_name -> cinema_service.Timestamp
+	4,  // 44: cinema_service.CityScreening.salesCloseAt:type_name -> cinema_service.Timestamp
+	4,  // 45: cinema_service.CityScreening.endTime:type_name -> cinema_service.Timestamp
+	31, // 46: cinema_service.CityScreenings.screenings:type_name -> cinema_service.CityScreening
+	2,  // 47: cinema_service.Place.availability:type_name -> cinema_service.PlaceAvailability
+	84, // 48: cinema_service.GetScreeningRequest.mask:type_name -> google.protobuf.FieldMask
+	4,  // 49: cinema_service.GetScreeningResponse.start_time:type_name -> cinema_service.Timestamp
+	7,  // 50: cinema_service.GetScreeningResponse.ticket_price:type_name -> cinema_service.Price
+	38, // 51: cinema_service.GetScreeningResponse.hall_configuration:type_name -> cinema_service.HallConfiguration
+	12, // 52: cinema_service.GetScreeningResponse.attributes:type_name -> cinema_service.ScreeningAttributes
+	13, // 53: cinema_service.GetScreeningResponse.status:type_name -> cinema_service.ScreeningStatusInfo
+	4,  // 54: cinema_service.GetScreeningResponse.salesOpenAt:type_name -> cinema_service.Timestamp
+	4,  // 55: cinema_service.GetScreeningResponse.salesCloseAt:type_name -> cinema_service.Timestamp
+	4,  // 56: cinema_service.GetScreeningResponse.endTime:type_name -> cinema_service.Timestamp
+	35, // 57: cinema_service.HallConfiguration.place:type_name -> cinema_service.Place
+	0,  // 58: cinema_service.UpdateScreeningStatusRequest.status:type_name -> cinema_service.ScreeningStatus
+	4,  // 59: cinema_service.Event.startDate:type_name -> cinema_service.Timestamp
+	4,  // 60: cinema_service.Event.endDate:type_name -> cinema_service.Timestamp
+	42, // 61: cinema_service.Events.events:type_name -> cinema_service.Event
+	4,  // 62: cinema_service.CreateScreeningRequest.startTime:type_name -> cinema_service.Timestamp
+	7,  // 63: cinema_service.CreateScreeningRequest.ticketPrice:type_name -> cinema_service.Price
+	12, // 64: cinema_service.CreateScreeningRequest.attributes:type_name -> cinema_service.ScreeningAttributes
+	4,  // 65: cinema_service.CreateHallMaintenanceWindowRequest.startTime:type_name -> cinema_service.Timestamp
+	4,  // 66: cinema_service.CreateHallMaintenanceWindowRequest.endTime:type_name -> cinema_service.Timestamp
+	52, // 67: cinema_service.Chains.chains:type_name -> cinema_service.Chain
+	3,  // 68: cinema_service.SearchResult.type:type_name -> cinema_service.SearchResultType
+	55, // 69: cinema_service.SearchResponse.results:type_name -> cinema_service.SearchResult
+	58, // 70: cinema_service.CitiesSuggestions.cities:type_name -> cinema_service.CitySuggestion
+	17, // 71: cinema_service.CinemasCluster.centroid:type_name -> cinema_service.Coordinates
+	18, // 72: cinema_service.CinemasInBounds.cinemas:type_name -> cinema_service.Cinema
+	61, // 73: cinema_service.CinemasInBounds.clusters:type_name -> cinema_service.CinemasCluster
+	22, // 74: cinema_service.ResolveCityResponse.city:type_name -> cinema_service.City
+	65, // 75: cinema_service.Countries.countries:type_name -> cinema_service.Country
+	68, // 76: cinema_service.Regions.regions:type_name -> cinema_service.Region
+	4,  // 77: cinema_service.CityMovie.firstScreeningTime:type_name -> cinema_service.Timestamp
+	72, // 78: cinema_service.CityMovies.movies:type_name -> cinema_service.CityMovie
+	14, // 79: cinema_service.MovieUpcomingScreenings.screenings:type_name -> cinema_service.Screening
+	75, // 80: cinema_service.MoviesUpcomingScreenings.movies:type_name -> cinema_service.MovieUpcomingScreenings
+	31, // 81: cinema_service.AlternativeScreening.screening:type_name -> cinema_service.CityScreening
+	78, // 82: cinema_service.AlternativeScreenings.screenings:type_name -> cinema_service.AlternativeScreening
+	18, // 83: cinema_service.CinemasByIds.cinemas:type_name -> cinema_service.Cinema
+	14, // 84: cinema_service.ScreeningsByIds.screenings:type_name -> cinema_service.Screening
+	85, // [85:85] is the sub-list for method output_type
+	85, // [85:85] is the sub-list for method input_type
+	85, // [85:85] is the sub-list for extension type_name
+	85, // [85:85] is the sub-list for extension extendee
+	0,  // [0:85] is the sub-list for field type_name
+}
+
+func init() { file_cinema_service_v1_messages_proto_init() }
+func file_cinema_service_v1_messages_proto_init() {
+	if File_cinema_service_v1_messages_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cinema_service_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timestamp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMoviesScreeningsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMoviesScreeningsInCitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Price); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewScreening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovieScreeningsStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewScreenings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningStatusInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Screening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Screenings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemasInCityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Coordinates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cinema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpeningHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CinemaClosure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cinemas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*City); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HallCategoryCapacity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HallMaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HallMaintenanceWindows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Halls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningsInCityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityScreening); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityScreenings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHallsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHallConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Place); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HallConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemaHalls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScreeningStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Events); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventScreeningsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScreeningRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScreeningResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHallMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHallMaintenanceWindowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCinemaPhotoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadCinemaPhotoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCinemaHalls.ProtoReflect.Descriptor instead.
-func (*GetCinemaHalls) Descriptor() ([]byte, []int) {
-	return file_cinema_service_v1_messages_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetCinemaHalls) GetCinemaID() int32 {
-	if x != nil {
-		return x.CinemaID
-	}
-	return 0
-}
-
-var File_cinema_service_v1_messages_proto protoreflect.FileDescriptor
-
-var file_cinema_service_v1_messages_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x76, 0x31, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x2f, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73,
-	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x3c,
-	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09,
-	0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4d, 0x6f,
-	0x76, 0x69, 0x65, 0x73, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6e,
-	0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
-	0x09, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x0a, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
-	0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65,
-	0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x79, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f,
-	0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76,
-	0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
-	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x12, 0x1f, 0x0a, 0x0a, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x22, 0x55, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63,
-	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x19,
-	0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x21, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x25, 0x0a,
-	0x0d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17,
-	0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
-	0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0x47, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x39, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a,
-	0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x47,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x79, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x79, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
-	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
-	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x06, 0x43, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x07,
-	0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61,
-	0x52, 0x07, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x33, 0x0a, 0x04, 0x43, 0x69, 0x74,
-	0x79, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36,
-	0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x06,
-	0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x04, 0x48, 0x61, 0x6c, 0x6c, 0x12, 0x17,
-	0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x68, 0x61, 0x6c, 0x6c, 0x53,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x6c, 0x6c, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x33, 0x0a, 0x05,
-	0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x68, 0x61, 0x6c, 0x6c,
-	0x73, 0x22, 0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49,
-	0x44, 0x22, 0xc8, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x49, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76,
-	0x69, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69,
-	0x65, 0x5f, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65,
-	0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x83, 0x02, 0x0a,
-	0x0d, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21,
-	0x0a, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x25,
-	0x0a, 0x0d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12,
-	0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x4f, 0x0a, 0x0e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d,
-	0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x53, 0x63,
-	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x49,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x5f,
-	0x69, 0x64, 0x73, 0x22, 0x36, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x05, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x72,
-	0x69, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x5f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
-	0x67, 0x72, 0x69, 0x64, 0x50, 0x6f, 0x73, 0x58, 0x12, 0x1c, 0x0a, 0x0a, 0x67, 0x72, 0x69, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x5f, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x67, 0x72,
-	0x69, 0x64, 0x50, 0x6f, 0x73, 0x59, 0x22, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72,
-	0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b,
-	0x22, 0xd8, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x69, 0x6e,
-	0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69,
-	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x72, 0x65, 0x65,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a,
-	0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x12, 0x68, 0x61, 0x6c, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x11, 0x48,
-	0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x2d, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x43, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x48, 0x61, 0x6c, 0x6c, 0x73, 0x12,
-	0x1b, 0x0a, 0x08, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x42, 0x1a, 0x5a, 0x18,
-	0x63, 0x69, 0x6e, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_cinema_service_v1_messages_proto_rawDescOnce sync.Once
-	file_cinema_service_v1_messages_proto_rawDescData = file_cinema_service_v1_messages_proto_rawDesc
-)
-
-func file_cinema_service_v1_messages_proto_rawDescGZIP() []byte {
-	file_cinema_service_v1_messages_proto_rawDescOnce.Do(func() {
-		file_cinema_service_v1_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_cinema_service_v1_messages_proto_rawDescData)
-	})
-	return file_cinema_service_v1_messages_proto_rawDescData
-}
-
-var file_cinema_service_v1_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
-var file_cinema_service_v1_messages_proto_goTypes = []interface{}{
-	(*Timestamp)(nil),                          // 0: cinema_service.Timestamp
-	(*GetMoviesScreeningsRequest)(nil),         // 1: cinema_service.GetMoviesScreeningsRequest
-	(*GetMoviesScreeningsInCitiesRequest)(nil), // 2: cinema_service.GetMoviesScreeningsInCitiesRequest
-	(*Price)(nil),                              // 3: cinema_service.Price
-	(*PreviewScreening)(nil),                   // 4: cinema_service.PreviewScreening
-	(*PreviewScreenings)(nil),                  // 5: cinema_service.PreviewScreenings
-	(*GetScreeningsRequest)(nil),               // 6: cinema_service.GetScreeningsRequest
-	(*Screening)(nil),                          // 7: cinema_service.Screening
-	(*Screenings)(nil),                         // 8: cinema_service.Screenings
-	(*GetCinemasInCityRequest)(nil),            // 9: cinema_service.GetCinemasInCityRequest
-	(*Coordinates)(nil),                        // 10: cinema_service.Coordinates
-	(*Cinema)(nil),                             // 11: cinema_service.Cinema
-	(*Cinemas)(nil),                            // 12: cinema_service.Cinemas
-	(*City)(nil),                               // 13: cinema_service.City
-	(*Cities)(nil),                             // 14: cinema_service.Cities
-	(*Hall)(nil),                               // 15: cinema_service.Hall
-	(*Halls)(nil),                              // 16: cinema_service.Halls
-	(*GetCinemaRequest)(nil),                   // 17: cinema_service.GetCinemaRequest
-	(*GetScreeningsInCityRequest)(nil),         // 18: cinema_service.GetScreeningsInCityRequest
-	(*CityScreening)(nil),                      // 19: cinema_service.CityScreening
-	(*CityScreenings)(nil),                     // 20: cinema_service.CityScreenings
-	(*GetHallsRequest)(nil),                    // 21: cinema_service.GetHallsRequest
-	(*GetHallConfigurationRequest)(nil),        // 22: cinema_service.GetHallConfigurationRequest
-	(*Place)(nil),                              // 23: cinema_service.Place
-	(*GetScreeningRequest)(nil),                // 24: cinema_service.GetScreeningRequest
-	(*GetScreeningResponse)(nil),               // 25: cinema_service.GetScreeningResponse
-	(*HallConfiguration)(nil),                  // 26: cinema_service.HallConfiguration
-	(*GetCinemaHalls)(nil),                     // 27: cinema_service.GetCinemaHalls
-	(*fieldmaskpb.FieldMask)(nil),              // 28: google.protobuf.FieldMask
-}
-var file_cinema_service_v1_messages_proto_depIdxs = []int32{
-	0,  // 0: cinema_service.GetMoviesScreeningsRequest.startPeriod:type_name -> cinema_service.Timestamp
-	0,  // 1: cinema_service.GetMoviesScreeningsRequest.endPeriod:type_name -> cinema_service.Timestamp
-	0,  // 2: cinema_service.GetMoviesScreeningsInCitiesRequest.startPeriod:type_name -> cinema_service.Timestamp
-	0,  // 3: cinema_service.GetMoviesScreeningsInCitiesRequest.endPeriod:type_name -> cinema_service.Timestamp
-	4,  // 4: cinema_service.PreviewScreenings.screenings:type_name -> cinema_service.PreviewScreening
-	0,  // 5: cinema_service.GetScreeningsRequest.startPeriod:type_name -> cinema_service.Timestamp
-	0,  // 6: cinema_service.GetScreeningsRequest.endPeriod:type_name -> cinema_service.Timestamp
-	0,  // 7: cinema_service.Screening.startTime:type_name -> cinema_service.Timestamp
-	3,  // 8: cinema_service.Screening.ticketPrice:type_name -> cinema_service.Price
-	7,  // 9: cinema_service.Screenings.screenings:type_name -> cinema_service.Screening
-	10, // 10: cinema_service.Cinema.coordinates:type_name -> cinema_service.Coordinates
-	11, // 11: cinema_service.Cinemas.cinemas:type_name -> cinema_service.Cinema
-	13, // 12: cinema_service.Cities.cities:type_name -> cinema_service.City
-	15, // 13: cinema_service.Halls.halls:type_name -> cinema_service.Hall
-	0,  // 14: cinema_service.GetScreeningsInCityRequest.startPeriod:type_name -> cinema_service.Timestamp
-	0,  // 15: cinema_service.GetScreeningsInCityRequest.endPeriod:type_name -> cinema_service.Timestamp
-	0,  // 16: cinema_service.CityScreening.startTime:type_name -> cinema_service.Timestamp
-	3,  // 17: cinema_service.CityScreening.ticketPrice:type_name -> cinema_service.Price
-	19, // 18: cinema_service.CityScreenings.screenings:type_name -> cinema_service.CityScreening
-	28, // 19: cinema_service.GetScreeningRequest.mask:type_name -> google.protobuf.FieldMask
-	0,  // 20: cinema_service.GetScreeningResponse.start_time:type_name -> cinema_service.Timestamp
-	3,  // 21: cinema_service.GetScreeningResponse.ticket_price:type_name -> cinema_service.Price
-	26, // 22: cinema_service.GetScreeningResponse.hall_configuration:type_name -> cinema_service.HallConfiguration
-	23, // 23: cinema_service.HallConfiguration.place:type_name -> cinema_service.Place
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
-}
-
-func init() { file_cinema_service_v1_messages_proto_init() }
-func file_cinema_service_v1_messages_proto_init() {
-	if File_cinema_service_v1_messages_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_cinema_service_v1_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Timestamp); i {
+		file_cinema_service_v1_messages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1970,8 +7721,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMoviesScreeningsRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chains); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1982,8 +7733,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMoviesScreeningsInCitiesRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,8 +7745,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Price); i {
+		file_cinema_service_v1_messages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2006,8 +7757,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreviewScreening); i {
+		file_cinema_service_v1_messages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2018,8 +7769,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreviewScreenings); i {
+		file_cinema_service_v1_messages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutocompleteCitiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2030,8 +7781,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetScreeningsRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitySuggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2042,8 +7793,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Screening); i {
+		file_cinema_service_v1_messages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitiesSuggestions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2054,8 +7805,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Screenings); i {
+		file_cinema_service_v1_messages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemasInBoundsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2066,8 +7817,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCinemasInCityRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CinemasCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2078,8 +7829,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Coordinates); i {
+		file_cinema_service_v1_messages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CinemasInBounds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2090,8 +7841,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cinema); i {
+		file_cinema_service_v1_messages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2102,8 +7853,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cinemas); i {
+		file_cinema_service_v1_messages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2114,8 +7865,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*City); i {
+		file_cinema_service_v1_messages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Country); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2126,8 +7877,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cities); i {
+		file_cinema_service_v1_messages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Countries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2138,8 +7889,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Hall); i {
+		file_cinema_service_v1_messages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2150,8 +7901,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Halls); i {
+		file_cinema_service_v1_messages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Region); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2162,8 +7913,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCinemaRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Regions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2174,8 +7925,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetScreeningsInCityRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegionCitiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2186,8 +7937,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CityScreening); i {
+		file_cinema_service_v1_messages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCityMoviesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2198,8 +7949,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CityScreenings); i {
+		file_cinema_service_v1_messages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityMovie); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2210,8 +7961,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHallsRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CityMovies); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2222,8 +7973,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHallConfigurationRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemaUpcomingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2234,8 +7985,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Place); i {
+		file_cinema_service_v1_messages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovieUpcomingScreenings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2246,8 +7997,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetScreeningRequest); i {
+		file_cinema_service_v1_messages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoviesUpcomingScreenings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2258,8 +8009,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetScreeningResponse); i {
+		file_cinema_service_v1_messages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAlternativesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2270,8 +8021,8 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HallConfiguration); i {
+		file_cinema_service_v1_messages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlternativeScreening); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2282,8 +8033,56 @@ func file_cinema_service_v1_messages_proto_init() {
 				return nil
 			}
 		}
-		file_cinema_service_v1_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCinemaHalls); i {
+		file_cinema_service_v1_messages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlternativeScreenings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCinemasByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CinemasByIds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScreeningsByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cinema_service_v1_messages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreeningsByIds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2295,19 +8094,34 @@ func file_cinema_service_v1_messages_proto_init() {
 			}
 		}
 	}
+	file_cinema_service_v1_messages_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_cinema_service_v1_messages_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_cinema_service_v1_messages_proto_msgTypes[73].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cinema_service_v1_messages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   28,
+			NumEnums:      4,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cinema_service_v1_messages_proto_goTypes,
 		DependencyIndexes: file_cinema_service_v1_messages_proto_depIdxs,
+		EnumInfos:         file_cinema_service_v1_messages_proto_enumTypes,
 		MessageInfos:      file_cinema_service_v1_messages_proto_msgTypes,
 	}.Build()
 	File_cinema_service_v1_messages_proto = out.File
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.3
+// source: movies_service_v1.proto
+
+// Client side copy of the movies service API, contains only used methods.
+
+package protos
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMoviesPreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for multiple values use ',' as separator
+	MoviesIDs string `protobuf:"bytes,1,opt,name=moviesIDs,json=movies_ids,proto3" json:"moviesIDs,omitempty"`
+}
+
+func (x *GetMoviesPreviewRequest) Reset() {
+	*x = GetMoviesPreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_movies_service_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMoviesPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMoviesPreviewRequest) ProtoMessage() {}
+
+func (x *GetMoviesPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movies_service_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMoviesPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetMoviesPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_movies_service_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMoviesPreviewRequest) GetMoviesIDs() string {
+	if x != nil {
+		return x.MoviesIDs
+	}
+	return ""
+}
+
+type MoviePreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MovieID int32  `protobuf:"varint,1,opt,name=movieID,json=movie_id,proto3" json:"movieID,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// runtime in minutes
+	Duration  int32  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	AgeRating string `protobuf:"bytes,4,opt,name=ageRating,json=age_rating,proto3" json:"ageRating,omitempty"`
+}
+
+func (x *MoviePreview) Reset() {
+	*x = MoviePreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_movies_service_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoviePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoviePreview) ProtoMessage() {}
+
+func (x *MoviePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_movies_service_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoviePreview.ProtoReflect.Descriptor instead.
+func (*MoviePreview) Descriptor() ([]byte, []int) {
+	return file_movies_service_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoviePreview) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *MoviePreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MoviePreview) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MoviePreview) GetAgeRating() string {
+	if x != nil {
+		return x.AgeRating
+	}
+	return ""
+}
+
+type MoviesPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Movies []*MoviePreview `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+}
+
+func (x *MoviesPreview) Reset() {
+	*x = MoviesPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_movies_service_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoviesPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoviesPreview) ProtoMessage() {}
+
+func (x *MoviesPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_movies_service_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoviesPreview.ProtoReflect.Descriptor instead.
+func (*MoviesPreview) Descriptor() ([]byte, []int) {
+	return file_movies_service_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MoviesPreview) GetMovies() []*MoviePreview {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+var File_movies_service_v1_proto protoreflect.FileDescriptor
+
+var file_movies_service_v1_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x69, 0x64, 0x73, 0x22, 0x7a, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0x45, 0x0a, 0x0d, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x34, 0x0a, 0x06, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x32, 0x6d, 0x0a, 0x0f, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x27, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x1a, 0x5a, 0x18, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_movies_service_v1_proto_rawDescOnce sync.Once
+	file_movies_service_v1_proto_rawDescData = file_movies_service_v1_proto_rawDesc
+)
+
+func file_movies_service_v1_proto_rawDescGZIP() []byte {
+	file_movies_service_v1_proto_rawDescOnce.Do(func() {
+		file_movies_service_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_movies_service_v1_proto_rawDescData)
+	})
+	return file_movies_service_v1_proto_rawDescData
+}
+
+var file_movies_service_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_movies_service_v1_proto_goTypes = []interface{}{
+	(*GetMoviesPreviewRequest)(nil), // 0: movies_service.GetMoviesPreviewRequest
+	(*MoviePreview)(nil),            // 1: movies_service.MoviePreview
+	(*MoviesPreview)(nil),           // 2: movies_service.MoviesPreview
+}
+var file_movies_service_v1_proto_depIdxs = []int32{
+	1, // 0: movies_service.MoviesPreview.movies:type_name -> movies_service.MoviePreview
+	0, // 1: movies_service.moviesServiceV1.GetMoviesPreview:input_type -> movies_service.GetMoviesPreviewRequest
+	2, // 2: movies_service.moviesServiceV1.GetMoviesPreview:output_type -> movies_service.MoviesPreview
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_movies_service_v1_proto_init() }
+func file_movies_service_v1_proto_init() {
+	if File_movies_service_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_movies_service_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMoviesPreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_movies_service_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoviePreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_movies_service_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoviesPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_movies_service_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movies_service_v1_proto_goTypes,
+		DependencyIndexes: file_movies_service_v1_proto_depIdxs,
+		MessageInfos:      file_movies_service_v1_proto_msgTypes,
+	}.Build()
+	File_movies_service_v1_proto = out.File
+	file_movies_service_v1_proto_rawDesc = nil
+	file_movies_service_v1_proto_goTypes = nil
+	file_movies_service_v1_proto_depIdxs = nil
+}